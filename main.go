@@ -20,16 +20,24 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
 	"k8c.io/gchl/pkg/changelog"
+	"k8c.io/gchl/pkg/git"
+	"k8c.io/gchl/pkg/gitea"
 	"k8c.io/gchl/pkg/github"
+	"k8c.io/gchl/pkg/gitlab"
 	"k8c.io/gchl/pkg/ranges"
 	"k8c.io/gchl/pkg/render"
 	"k8c.io/gchl/pkg/signals"
+	"k8c.io/gchl/pkg/source"
 	"k8c.io/gchl/pkg/types"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -46,6 +54,19 @@ func main() {
 		log.Fatalf("Invalid options: %v", err)
 	}
 
+	// "gchl range" only explains the commit range instead of generating the changelog
+	explain := pflag.Arg(0) == "range"
+	if pflag.NArg() > 1 || (pflag.NArg() == 1 && !explain) {
+		log.Fatalf("Unknown arguments %v, only the \"range\" command is supported.", pflag.Args())
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
 	logger := logrus.New()
 	if opts.Verbose {
 		logger.SetLevel(logrus.DebugLevel)
@@ -56,30 +77,49 @@ func main() {
 		"version": opts.ForVersion,
 	})
 
-	client, err := github.NewClient(ctx, flogger, opts.GithubToken)
+	client, err := newSource(ctx, flogger, opts)
 	if err != nil {
-		log.Fatalf("Failed to create GitHub client: %v", err)
+		log.Fatalf("Failed to create client: %v", err)
 	}
 
-	flogger.Info("Resolving release commit range…")
-	head, stop, err := ranges.DetermineRange(ctx, client, flogger, opts)
-	if err != nil {
-		log.Fatalf("Failed to determine commit range: %v", err)
-	}
+	if explain {
+		if err := explainRange(ctx, flogger, opts, client, os.Stdout); err != nil {
+			log.Fatalf("Failed to explain commit range: %v", err)
+		}
 
-	flogger.Info("Fetching commit history…")
-	commits, err := client.History(ctx, opts.Organization, opts.Repository, head, stop)
-	if err != nil {
-		log.Fatalf("Failed to fetch repository history: %v", err)
+		return
 	}
-	flogger.WithField("total", len(commits)).Info("Done fetching history.")
 
-	commits = stripUnwantedCommits(commits)
-	flogger.WithField("remaining", len(commits)).Info("Filtered out unwanted commits.")
+	var (
+		commits     []types.Commit
+		preReleases *ranges.PreReleaseTracker
+	)
+
+	if query := opts.SearchQuery(); query != "" {
+		commits, err = searchCommits(ctx, flogger, opts, client, query)
+		if err != nil {
+			log.Fatalf("Failed to search pull requests: %v", err)
+		}
+	} else {
+		flogger.Info("Resolving release commit range…")
+		rng, err := ranges.DetermineRange(ctx, client, flogger, opts)
+		if err != nil {
+			log.Fatalf("Failed to determine commit range: %v", err)
+		}
+
+		preReleases = ranges.TrackPreReleases(&rng)
 
-	commits, err = replaceCherrypicksWithOriginals(ctx, flogger, opts, client, commits)
+		flogger.Info("Fetching commit history…")
+		commits, err = client.History(ctx, opts.Organization, opts.Repository, rng)
+		if err != nil {
+			log.Fatalf("Failed to fetch repository history: %v", err)
+		}
+		flogger.WithField("total", len(commits)).Info("Done fetching history.")
+	}
+
+	commits, err = filterCommits(ctx, flogger, opts, client, commits)
 	if err != nil {
-		log.Fatalf("Failed to filter out cherry picks: %v", err)
+		log.Fatalf("Failed to filter commits: %v", err)
 	}
 
 	if len(commits) > 0 {
@@ -99,12 +139,21 @@ func main() {
 		flogger.Warn("Changelog is empty.")
 	}
 
-	url := fmt.Sprintf("https://github.com/%s/%s", opts.Organization, opts.Repository)
-	gen := changelog.NewGenerator(opts.ForVersion, url, commits)
-	changelog, err := gen.Generate()
+	contributors := changelog.CollectContributors(commits, opts.ExcludeContributors)
+	if err := markFirstTimeContributors(ctx, flogger, opts, client, contributors); err != nil {
+		flogger.WithError(err).Warn("Failed to detect first-time contributors.")
+	}
+
+	naming, err := opts.NamingScheme()
+	if err != nil {
+		log.Fatalf("Invalid naming scheme: %v", err)
+	}
+
+	changelog, err := generateChangelog(opts, naming, semver.MustParse(opts.ForVersion), commits, preReleases)
 	if err != nil {
 		log.Fatalf("Failed to create changelog from commits: %v", err)
 	}
+	changelog.Contributors = contributors
 
 	var renderer render.Renderer
 	switch opts.OutputFormat {
@@ -124,6 +173,219 @@ func main() {
 	fmt.Println(output)
 }
 
+func newSource(ctx context.Context, log logrus.FieldLogger, opts *types.Options) (source.Source, error) {
+	var (
+		client source.Source
+		err    error
+	)
+
+	switch opts.Forge {
+	case "gitlab":
+		client, err = gitlab.NewClient(log, opts.GitlabURL, opts.GitlabToken, opts.Concurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+		}
+
+	case "gitea":
+		client, err = gitea.NewClient(log, opts.GiteaURL, opts.GiteaToken, opts.Concurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Gitea client: %w", err)
+		}
+
+	default:
+		clientOpts := github.ClientOptions{
+			URL:       opts.GithubURL,
+			Token:     opts.GithubToken,
+			CacheDir:  opts.CacheDir,
+			RecordDir: opts.RecordDir,
+			ReplayDir: opts.ReplayDir,
+
+			RequestTimeout: opts.RequestTimeout,
+			Concurrency:    opts.Concurrency,
+		}
+
+		if opts.GithubApp.Enabled() && opts.ReplayDir == "" {
+			privateKey, err := os.ReadFile(opts.GithubApp.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+			}
+
+			clientOpts.App = &github.AppCredentials{
+				AppID:          opts.GithubApp.AppID,
+				InstallationID: opts.GithubApp.InstallationID,
+				PrivateKey:     privateKey,
+			}
+		}
+
+		client, err = github.NewClient(ctx, log, clientOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+		}
+	}
+
+	if opts.RepoPath != "" {
+		return git.NewRepository(log, opts.RepoPath, client)
+	}
+
+	return client, nil
+}
+
+// generateChangelog creates the changelog for the version. If pre-releases
+// have been tracked, the commits that first appeared in each of them are
+// grouped into a nested changelog per pre-release.
+func generateChangelog(opts *types.Options, naming *types.NamingScheme, version *semver.Version, commits []types.Commit, preReleases *ranges.PreReleaseTracker) (*changelog.Changelog, error) {
+	commits, groups := ranges.SplitByPreReleases(commits, preReleases)
+
+	repoURL, releaseURL := repositoryURLs(opts, naming.Tag(version))
+	gen := changelog.NewGenerator(version.String(), repoURL, commits)
+	result, err := gen.Generate()
+	if err != nil {
+		return nil, err
+	}
+	result.ReleaseURL = releaseURL
+	result.Component = opts.Component
+
+	for _, group := range groups {
+		preVersion, err := naming.ParseTag(group.Tag.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		pre, err := generateChangelog(opts, naming, preVersion, group.Commits, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create changelog for %s: %w", group.Tag.Name, err)
+		}
+
+		result.PreReleases = append(result.PreReleases, pre)
+	}
+
+	return result, nil
+}
+
+// repositoryURLs returns the web URL of the repository and of the release
+// page for the tag.
+func repositoryURLs(opts *types.Options, tag string) (string, string) {
+	switch opts.Forge {
+	case "gitlab":
+		repoURL := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(opts.GitlabURL, "/"), opts.Organization, opts.Repository)
+		return repoURL, fmt.Sprintf("%s/-/releases/%s", repoURL, tag)
+
+	case "gitea":
+		repoURL := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(opts.GiteaURL, "/"), opts.Organization, opts.Repository)
+		return repoURL, fmt.Sprintf("%s/releases/tag/%s", repoURL, tag)
+
+	default:
+		repoURL := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(opts.GithubURL, "/"), opts.Organization, opts.Repository)
+		return repoURL, fmt.Sprintf("%s/releases/tag/%s", repoURL, tag)
+	}
+}
+
+// searchCommits collects the merged pull requests matching the query, instead
+// of walking the history of a commit range.
+func searchCommits(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, query string) ([]types.Commit, error) {
+	searcher, ok := client.(source.Searcher)
+	if !ok {
+		return nil, fmt.Errorf("searching is not supported with --forge=%s", opts.Forge)
+	}
+
+	log.WithField("query", query).Info("Searching pull requests…")
+	commits, err := searcher.SearchMergedPullRequests(ctx, opts.Organization, opts.Repository, query)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("total", len(commits)).Info("Done searching pull requests.")
+
+	return commits, nil
+}
+
+// filterCommits turns the history of a range into the commits that make up
+// the changelog: it drops direct commits (unless they should be included),
+// commits outside of the component and unwanted commits, and replaces the
+// pull requests of cherry-picks with the original ones.
+func filterCommits(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, commits []types.Commit) ([]types.Commit, error) {
+	if !opts.IncludeDirectCommits {
+		commits = slices.DeleteFunc(slices.Clone(commits), func(c types.Commit) bool {
+			return !c.HasPullRequest()
+		})
+	}
+
+	if opts.Component != "" {
+		var err error
+
+		commits, err = filterComponentCommits(ctx, log, opts, client, commits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter commits by component: %w", err)
+		}
+	}
+
+	commits = stripUnwantedCommits(commits)
+	log.WithField("remaining", len(commits)).Info("Filtered out unwanted commits.")
+
+	commits, err := replaceCherrypicksWithOriginals(ctx, log, opts, client, commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter out cherry picks: %w", err)
+	}
+
+	return commits, nil
+}
+
+// filterComponentCommits keeps only the commits whose pull request changed
+// files in any of the component's paths.
+func filterComponentCommits(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, commits []types.Commit) ([]types.Commit, error) {
+	fileSource, ok := client.(source.FileSource)
+	if !ok {
+		return nil, fmt.Errorf("--component is not supported with --forge=%s", opts.Forge)
+	}
+
+	numbers := sets.NewInt()
+	for _, commit := range commits {
+		if commit.HasPullRequest() {
+			numbers.Insert(commit.PullRequest.Number)
+		}
+	}
+
+	log.WithField("total", numbers.Len()).Info("Fetching changed files…")
+	files, err := fileSource.PullRequestFiles(ctx, opts.Organization, opts.Repository, numbers.List())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch changed files: %w", err)
+	}
+
+	result := []types.Commit{}
+	for _, commit := range commits {
+		// commits without a pull request cannot be attributed to a component
+		if !commit.HasPullRequest() {
+			continue
+		}
+
+		if changesPaths(files[commit.PullRequest.Number], opts.ComponentPaths) {
+			result = append(result, commit)
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"component": opts.Component,
+		"remaining": len(result),
+	}).Info("Filtered out commits of other components.")
+
+	return result, nil
+}
+
+// changesPaths returns true if any of the files is one of the paths or
+// inside of one of them.
+func changesPaths(files []string, paths []string) bool {
+	for _, path := range paths {
+		path = strings.Trim(path, "/")
+
+		for _, file := range files {
+			if path == "" || file == path || strings.HasPrefix(file, path+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func stripUnwantedCommits(commits []types.Commit) []types.Commit {
 	result := []types.Commit{}
 
@@ -139,7 +401,7 @@ func stripUnwantedCommits(commits []types.Commit) []types.Commit {
 	return result
 }
 
-func replaceCherrypicksWithOriginals(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client *github.Client, commits []types.Commit) ([]types.Commit, error) {
+func replaceCherrypicksWithOriginals(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, commits []types.Commit) ([]types.Commit, error) {
 	// walk through all commits and collect PR numbers to fetch
 	toFetch := sets.NewInt()
 	for _, commit := range commits {
@@ -180,6 +442,40 @@ func replaceCherrypicksWithOriginals(ctx context.Context, log logrus.FieldLogger
 	return commits, nil
 }
 
+// markFirstTimeContributors flags all contributors whose first merged pull
+// request is part of this release. Sources that cannot tell are skipped.
+func markFirstTimeContributors(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, contributors []changelog.Contributor) error {
+	contributorSource, ok := client.(source.ContributorSource)
+	if !ok {
+		return nil
+	}
+
+	authors := []string{}
+	for _, contributor := range contributors {
+		if len(contributor.PullRequests) > 0 {
+			authors = append(authors, contributor.Login)
+		}
+	}
+
+	if len(authors) == 0 {
+		return nil
+	}
+
+	log.WithField("total", len(authors)).Info("Checking for first-time contributors…")
+	firstPullRequests, err := contributorSource.FirstMergedPullRequests(ctx, opts.Organization, opts.Repository, authors)
+	if err != nil {
+		return err
+	}
+
+	for i, contributor := range contributors {
+		if number, ok := firstPullRequests[contributor.Login]; ok && slices.Contains(contributor.PullRequests, number) {
+			contributors[i].FirstTime = true
+		}
+	}
+
+	return nil
+}
+
 var automatedCherrypickRegex = regexp.MustCompile(`This is an automated cherry-pick of #([0-9]+)`)
 
 func getCherrypickedFrom(prBody string) int {
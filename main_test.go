@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"k8c.io/gchl/pkg/ranges"
+	"k8c.io/gchl/pkg/render"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func TestGenerateChangelogWithPreReleases(t *testing.T) {
+	commits := []types.Commit{}
+	for i, note := range []string{"Add feature", "Fix bug"} {
+		commits = append(commits, types.Commit{
+			Hash: strings.Repeat(string(rune('a'+i)), 40),
+			PullRequest: types.PullRequest{
+				Number: i + 1,
+				Body:   "```release-note\n" + note + "\n```",
+			},
+		})
+	}
+
+	// the second commit first appeared in v2.22.0-rc.1
+	rng := types.Range{
+		Stop: func(c types.Commit) (bool, error) {
+			return false, nil
+		},
+		PreReleases: []types.Ref{{Name: "v2.22.0-rc.1", Hash: commits[1].Hash}},
+	}
+
+	tracker := ranges.TrackPreReleases(&rng)
+	for _, commit := range commits {
+		if _, err := rng.Stop(commit); err != nil {
+			t.Fatalf("Failed to walk commits: %v", err)
+		}
+	}
+
+	opts := &types.Options{
+		Organization: "kubermatic",
+		Repository:   "gchl",
+		GithubURL:    "https://github.com",
+	}
+
+	naming, err := opts.NamingScheme()
+	if err != nil {
+		t.Fatalf("Invalid naming scheme: %v", err)
+	}
+
+	log, err := generateChangelog(opts, naming, semver.MustParse("2.22.0"), commits, tracker)
+	if err != nil {
+		t.Fatalf("Failed to generate changelog: %v", err)
+	}
+
+	output, err := render.NewMarkdownRenderer().Render(log)
+	if err != nil {
+		t.Fatalf("Failed to render changelog: %v", err)
+	}
+
+	for _, expected := range []string{
+		"## v2.22.0\n",
+		"## v2.22.0-rc.1\n",
+		"**Release: [v2.22.0-rc.1](https://github.com/kubermatic/gchl/releases/tag/v2.22.0-rc.1)**",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q:\n%s", expected, output)
+		}
+	}
+
+	if strings.Contains(output, "vv") {
+		t.Errorf("Expected versions to be prefixed only once:\n%s", output)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8c.io/gchl/pkg/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type Contributor struct {
+	// Login is the user's handle on the forge. It can be empty for co-authors
+	// that could not be mapped to an account.
+	Login string `yaml:"login,omitempty" json:"login,omitempty"`
+	// Name is only set for co-authors without a known login.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// PullRequests are the pull requests the contributor authored in this release.
+	PullRequests []int `yaml:"pullRequests,omitempty" json:"pullRequests,omitempty"`
+	// FirstTime is true if the contributor's first merged pull request in the
+	// repository is part of this release.
+	FirstTime bool `yaml:"firstTime,omitempty" json:"firstTime,omitempty"`
+}
+
+var (
+	coAuthorRegex       = regexp.MustCompile(`(?im)^co-authored-by:[ \t]*(.*?)[ \t]*<([^>]*)>[ \t]*$`)
+	noreplyAddressRegex = regexp.MustCompile(`(?i)^(?:[0-9]+\+)?([^@]+)@users\.noreply\.github\.com$`)
+)
+
+// CollectContributors returns the authors of all pull requests and the
+// co-authors named in the commit messages, sorted by name. Bots and the
+// excluded users are skipped. Excluded users are matched case-insensitively
+// and regardless of the "[bot]" suffix.
+func CollectContributors(commits []types.Commit, excluded []string) []Contributor {
+	excludedSet := sets.New[string]()
+	for _, user := range excluded {
+		excludedSet.Insert(normalizeUser(user))
+	}
+
+	isExcluded := func(user string) bool {
+		return user == "" || excludedSet.Has(normalizeUser(user)) || types.GuessAuthorType(user) == types.AuthorTypeBot
+	}
+
+	contributors := map[string]*Contributor{}
+	get := func(login string, name string) *Contributor {
+		key := strings.ToLower(login)
+		if key == "" {
+			key = "name:" + strings.ToLower(name)
+		}
+
+		if _, ok := contributors[key]; !ok {
+			contributors[key] = &Contributor{Login: login, Name: name}
+		}
+
+		return contributors[key]
+	}
+
+	for _, commit := range commits {
+		pr := commit.PullRequest
+
+		if commit.HasPullRequest() && pr.AuthorType != types.AuthorTypeBot && !isExcluded(pr.Author) {
+			// the pull request author is spelled canonically, unlike co-authors
+			contributor := get(pr.Author, "")
+			contributor.Login = pr.Author
+			if !slices.Contains(contributor.PullRequests, pr.Number) {
+				contributor.PullRequests = append(contributor.PullRequests, pr.Number)
+			}
+		}
+
+		for _, match := range coAuthorRegex.FindAllStringSubmatch(commit.Message, -1) {
+			name, address := match[1], match[2]
+
+			login := ""
+			if noreply := noreplyAddressRegex.FindStringSubmatch(address); noreply != nil {
+				login = noreply[1]
+			}
+
+			switch {
+			case login != "":
+				if !isExcluded(login) {
+					get(login, "")
+				}
+
+			case !isExcluded(name):
+				get("", name)
+			}
+		}
+	}
+
+	result := []Contributor{}
+	for _, contributor := range contributors {
+		slices.Sort(contributor.PullRequests)
+		result = append(result, *contributor)
+	}
+
+	slices.SortFunc(result, func(a, b Contributor) int {
+		return strings.Compare(strings.ToLower(a.DisplayName()), strings.ToLower(b.DisplayName()))
+	})
+
+	return result
+}
+
+// DisplayName returns the login or, if no login is known, the name.
+func (c Contributor) DisplayName() string {
+	if c.Login != "" {
+		return c.Login
+	}
+
+	return c.Name
+}
+
+func normalizeUser(user string) string {
+	user = strings.ToLower(strings.TrimSpace(user))
+	user = strings.TrimPrefix(user, "app/")
+	user = strings.TrimSuffix(user, "[bot]")
+
+	return user
+}
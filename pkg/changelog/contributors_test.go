@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog
+
+import (
+	"reflect"
+	"testing"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestCollectContributors(t *testing.T) {
+	commit := func(number int, author string, message string) types.Commit {
+		return types.Commit{
+			Message: message,
+			PullRequest: types.PullRequest{
+				Number: number,
+				Author: author,
+			},
+		}
+	}
+
+	commits := []types.Commit{
+		commit(3, "zoe", "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: Bob <123+Bob@users.noreply.github.com>"),
+		commit(2, "bob", "Fix bug"),
+		commit(1, "zoe", "Fix another bug"),
+		commit(4, "dependabot[bot]", "Bump dependency"),
+		commit(5, "renovate", "Update dependency\n\nCo-authored-by: github-actions[bot] <41898282+github-actions[bot]@users.noreply.github.com>"),
+		commit(0, "", "Direct push\n\nCo-authored-by: alice <alice@example.com>"),
+	}
+
+	contributors := CollectContributors(commits, []string{"renovate"})
+
+	expected := []Contributor{
+		{Name: "alice"},
+		{Login: "bob", PullRequests: []int{2}},
+		{Name: "Jane Doe"},
+		{Login: "zoe", PullRequests: []int{1, 3}},
+	}
+
+	if !reflect.DeepEqual(expected, contributors) {
+		t.Fatalf("Expected contributors\n%+v\ngot\n%+v", expected, contributors)
+	}
+}
@@ -27,8 +27,10 @@ import (
 )
 
 type generateChangesTestcase struct {
-	PR      types.PullRequest `yaml:"pr"`
-	Changes []Change          `yaml:"changes"`
+	// PR is empty for direct commits, which only have a message.
+	PR      *types.PullRequest `yaml:"pr"`
+	Message string             `yaml:"message"`
+	Changes []Change           `yaml:"changes"`
 }
 
 func TestGenerateChanges(t *testing.T) {
@@ -53,9 +55,19 @@ func TestGenerateChanges(t *testing.T) {
 				t.Fatalf("Failed to load testcase: %v", err)
 			}
 
-			changes, err := processCommit(types.Commit{
-				PullRequest: testcase.PR,
-			})
+			commit := types.Commit{
+				Message: testcase.Message,
+			}
+
+			// the fixtures do not need to number their pull requests
+			if testcase.PR != nil {
+				commit.PullRequest = *testcase.PR
+				if commit.PullRequest.Number == 0 {
+					commit.PullRequest.Number = 1
+				}
+			}
+
+			changes, err := processCommit(commit)
 			if err != nil {
 				t.Fatalf("Failed to generate changes: %v", err)
 			}
@@ -28,7 +28,16 @@ import (
 
 func processCommit(commit types.Commit) ([]Change, error) {
 	commitType := commitChangeType(commit)
-	releaseNotes := extractReleaseNotes(commitType, commit.PullRequest.Body)
+
+	var releaseNotes []releaseNote
+	if commit.HasPullRequest() {
+		releaseNotes = extractReleaseNotes(commitType, commit.PullRequest.Body)
+	} else {
+		// commits pushed without a pull request can carry their release notes
+		// in the commit message, either as a block or as trailers
+		releaseNotes = extractReleaseNotes(commitType, commit.Message)
+		releaseNotes = append(releaseNotes, extractReleaseNoteTrailers(commitType, commit.Message)...)
+	}
 
 	var changes []Change
 	for _, rn := range releaseNotes {
@@ -37,6 +46,7 @@ func processCommit(commit types.Commit) ([]Change, error) {
 
 	for i := range changes {
 		changes[i].Commit = commit
+		changes[i].Issues = commit.PullRequest.Issues
 	}
 
 	// sort changes by text
@@ -91,6 +101,22 @@ func extractReleaseNotes(commitType ChangeType, body string) []releaseNote {
 	return releaseNotes
 }
 
+var releaseNoteTrailerRegex = regexp.MustCompile(`(?im)^release-note:[ \t]*(.*)$`)
+
+// extractReleaseNoteTrailers returns a release note for every
+// "Release-Note: ..." trailer in a commit message.
+func extractReleaseNoteTrailers(commitType ChangeType, message string) []releaseNote {
+	var releaseNotes []releaseNote
+	for _, match := range releaseNoteTrailerRegex.FindAllStringSubmatch(message, -1) {
+		releaseNotes = append(releaseNotes, releaseNote{
+			Type: commitType,
+			Text: strings.TrimSpace(match[1]),
+		})
+	}
+
+	return releaseNotes
+}
+
 func (rn *releaseNote) Changes() []Change {
 	if rn.Text == "" || strings.ToLower(rn.Text) == "none" {
 		return nil
@@ -242,10 +268,16 @@ func harmonizeLinePrefixes(text string) string {
 	return text
 }
 
+// kindLabelPrefixes are the label prefixes that denote a change type, the
+// classic "kind/bug" and GitLab's scoped labels ("kind::bug").
+var kindLabelPrefixes = []string{"kind/", "kind::"}
+
 func commitChangeType(commit types.Commit) ChangeType {
 	for _, label := range commit.PullRequest.Labels {
-		if strings.HasPrefix(label, "kind/") {
-			return ParseChangeType(strings.TrimPrefix(label, "kind/"))
+		for _, prefix := range kindLabelPrefixes {
+			if strings.HasPrefix(label, prefix) {
+				return ParseChangeType(strings.TrimPrefix(label, prefix))
+			}
 		}
 	}
 
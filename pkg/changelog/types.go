@@ -73,8 +73,14 @@ func ParseChangeType(s string) ChangeType {
 
 type Changelog struct {
 	Version       string        `yaml:"version" json:"version"`
+	Component     string        `yaml:"component,omitempty" json:"component,omitempty"`
 	RepositoryURL string        `yaml:"repository" json:"repository"`
+	ReleaseURL    string        `yaml:"releaseURL,omitempty" json:"releaseURL,omitempty"`
 	ChangeGroups  []ChangeGroup `yaml:"groups" json:"groups"`
+	Contributors  []Contributor `yaml:"contributors,omitempty" json:"contributors,omitempty"`
+	// PreReleases contains the changes that first appeared in each
+	// pre-release of the version, newest first.
+	PreReleases []*Changelog `yaml:"preReleases,omitempty" json:"preReleases,omitempty"`
 }
 
 type ChangeGroup struct {
@@ -88,6 +94,9 @@ type Change struct {
 	Type     ChangeType `yaml:"type"`
 	Breaking bool       `yaml:"breaking,omitempty"`
 	Text     string     `yaml:"releaseNote"`
+
+	// Issues are the issues closed by the change's pull request.
+	Issues []types.Issue `yaml:"issues,omitempty" json:"issues,omitempty"`
 }
 
 func (c *Changelog) BreakingChanges() []Change {
@@ -0,0 +1,427 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// remote is the remote whose branches are considered in addition to the
+// local branches. CI systems usually only have remote tracking branches.
+const remote = "origin"
+
+// Repository reads refs and history from a local git clone by invoking
+// the git binary. Pull request details are fetched from a forge, the
+// pull request numbers are taken from the merge/squash commit messages.
+type Repository struct {
+	path  string
+	forge source.PullRequestSource
+	log   logrus.FieldLogger
+}
+
+var (
+	_ source.Source            = &Repository{}
+	_ source.ContributorSource = &Repository{}
+	_ source.Searcher          = &Repository{}
+	_ source.ReleaseSource     = &Repository{}
+	_ source.MergeBaser        = &Repository{}
+)
+
+func NewRepository(log logrus.FieldLogger, path string, forge source.PullRequestSource) (*Repository, error) {
+	if path == "" {
+		return nil, errors.New("path cannot be empty")
+	}
+
+	if forge == nil {
+		return nil, errors.New("forge cannot be nil")
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	return &Repository{
+		path:  path,
+		forge: forge,
+		log:   log,
+	}, nil
+}
+
+func (r *Repository) References(ctx context.Context, _ string, _ string) (types.RepositoryRefs, error) {
+	result := types.RepositoryRefs{}
+
+	r.log.Debug("git for-each-ref")
+
+	output, err := r.git(ctx, "for-each-ref", "--format=%(refname)%00%(objectname)%00%(*objectname)", "refs/heads", "refs/remotes/"+remote, "refs/tags")
+	if err != nil {
+		return result, fmt.Errorf("failed to list references: %w", err)
+	}
+
+	localBranches := sets.New[string]()
+	remoteBranches := []types.Ref{}
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 3 {
+			return result, fmt.Errorf("unexpected reference %q", line)
+		}
+
+		// for annotated tags, the peeled object is the commit the tag points to
+		ref := types.Ref{Hash: fields[1]}
+		if fields[2] != "" {
+			ref.Hash = fields[2]
+		}
+
+		switch name := fields[0]; {
+		case strings.HasPrefix(name, "refs/heads/"):
+			ref.Name = strings.TrimPrefix(name, "refs/heads/")
+			localBranches.Insert(ref.Name)
+			result.Branches = append(result.Branches, ref)
+
+		case strings.HasPrefix(name, "refs/remotes/"+remote+"/"):
+			ref.Name = strings.TrimPrefix(name, "refs/remotes/"+remote+"/")
+			if ref.Name != "HEAD" {
+				remoteBranches = append(remoteBranches, ref)
+			}
+
+		case strings.HasPrefix(name, "refs/tags/"):
+			ref.Name = strings.TrimPrefix(name, "refs/tags/")
+			result.Tags = append(result.Tags, ref)
+		}
+	}
+
+	// local branches take precedence over the remote tracking branches
+	for _, ref := range remoteBranches {
+		if !localBranches.Has(ref.Name) {
+			result.Branches = append(result.Branches, ref)
+		}
+	}
+
+	result.DefaultBranch, err = r.defaultBranch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to determine default branch: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *Repository) defaultBranch(ctx context.Context) (string, error) {
+	// the remote's HEAD is the most reliable source, if it was fetched
+	if output, err := r.git(ctx, "symbolic-ref", "--short", "refs/remotes/"+remote+"/HEAD"); err == nil {
+		return strings.TrimPrefix(strings.TrimSpace(output), remote+"/"), nil
+	}
+
+	// otherwise assume the currently checked out branch is the default
+	output, err := r.git(ctx, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", errors.New("neither the remote HEAD nor the local HEAD point to a branch")
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+// History will return all commits, beginning with the head hash, until the stop
+// function returns true. Only the first parents are followed.
+func (r *Repository) History(ctx context.Context, owner string, name string, rng types.Range) ([]types.Commit, error) {
+	commits := []types.Commit{}
+
+	var stopErr error
+
+	err := r.walk(ctx, rng.Head, func(commit types.Commit) bool {
+		stopped, err := rng.Stop(commit)
+		if err != nil {
+			stopErr = err
+			return false
+		}
+
+		if stopped {
+			return false
+		}
+
+		if !commit.HasPullRequest() && !rng.IncludeDirectCommits {
+			r.log.WithField("commit", commit.Hash).Warn("Commit has no associated pull request.")
+			return true
+		}
+
+		commits = append(commits, commit)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+
+	if stopErr != nil {
+		return nil, stopErr
+	}
+
+	numbers := sets.New[int]()
+	for _, commit := range commits {
+		if commit.HasPullRequest() {
+			numbers.Insert(commit.PullRequest.Number)
+		}
+	}
+
+	if numbers.Len() == 0 {
+		return commits, nil
+	}
+
+	pullRequests, err := r.forge.FetchBatchPullRequests(ctx, owner, name, sets.List(numbers))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+	}
+
+	result := []types.Commit{}
+	for _, commit := range commits {
+		if !commit.HasPullRequest() {
+			result = append(result, commit)
+			continue
+		}
+
+		pr, ok := pullRequests[commit.PullRequest.Number]
+		if !ok {
+			r.log.WithFields(logrus.Fields{
+				"commit": commit.Hash,
+				"pr":     commit.PullRequest.Number,
+			}).Warn("Pull request referenced in commit message does not exist.")
+
+			if rng.IncludeDirectCommits {
+				commit.PullRequest = types.PullRequest{}
+				result = append(result, commit)
+			}
+
+			continue
+		}
+
+		commit.PullRequest = pr
+		commit.Author = pr.Author
+
+		result = append(result, commit)
+	}
+
+	return result, nil
+}
+
+// Log returns up to maxCommits commits, following only the first parents.
+// In contrast to History, the log contains all commits (including those
+// without a pull request) and no pull request details are fetched.
+func (r *Repository) Log(ctx context.Context, _ string, _ string, headHash string, maxCommits int) ([]types.Commit, error) {
+	commits := []types.Commit{}
+
+	err := r.walk(ctx, headHash, func(commit types.Commit) bool {
+		commits = append(commits, commit)
+		return len(commits) < maxCommits
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+
+	return commits, nil
+}
+
+// FirstMergedPullRequests is delegated to the forge. If the forge cannot tell,
+// no author is reported to have a merged pull request.
+func (r *Repository) FirstMergedPullRequests(ctx context.Context, owner string, name string, authors []string) (map[string]int, error) {
+	contributors, ok := r.forge.(source.ContributorSource)
+	if !ok {
+		return map[string]int{}, nil
+	}
+
+	return contributors.FirstMergedPullRequests(ctx, owner, name, authors)
+}
+
+// SearchMergedPullRequests is delegated to the forge, as the local clone knows
+// nothing about pull requests.
+func (r *Repository) SearchMergedPullRequests(ctx context.Context, owner string, name string, query string) ([]types.Commit, error) {
+	searcher, ok := r.forge.(source.Searcher)
+	if !ok {
+		return nil, errors.New("the forge does not support searching for pull requests")
+	}
+
+	return searcher.SearchMergedPullRequests(ctx, owner, name, query)
+}
+
+// PullRequestFiles is delegated to the forge, as the local clone knows nothing
+// about pull requests.
+func (r *Repository) PullRequestFiles(ctx context.Context, owner string, name string, numbers []int) (map[int][]string, error) {
+	files, ok := r.forge.(source.FileSource)
+	if !ok {
+		return nil, errors.New("the forge does not support listing the files of pull requests")
+	}
+
+	return files.PullRequestFiles(ctx, owner, name, numbers)
+}
+
+func (r *Repository) MergeBase(ctx context.Context, _ string, _ string, a string, b string) (string, error) {
+	output, err := r.git(ctx, "merge-base", a, b)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+// Releases is delegated to the forge, as releases are not part of git.
+func (r *Repository) Releases(ctx context.Context, owner string, name string) ([]types.Release, error) {
+	releases, ok := r.forge.(source.ReleaseSource)
+	if !ok {
+		return nil, errors.New("the forge does not support releases")
+	}
+
+	return releases.Releases(ctx, owner, name)
+}
+
+func (r *Repository) FetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+	return r.forge.FetchBatchPullRequests(ctx, owner, name, numbers)
+}
+
+const (
+	fieldSeparator  = "\x00"
+	commitSeparator = "\x1e"
+)
+
+// walk streams the first-parent history, beginning with the head, and calls
+// the callback for every commit until it returns false. The commits only
+// contain the hash, title, message and the pull request number (if any).
+func (r *Repository) walk(ctx context.Context, head string, callback func(types.Commit) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r.log.WithField("head", head).Debug("git log")
+
+	cmd := r.command(ctx, "log", "--first-parent", "--format=%H%x00%s%x00%B%x1e", head, "--")
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(nil, 16*1024*1024)
+	scanner.Split(splitCommits)
+
+	var (
+		walkErr error
+		stopped bool
+	)
+
+	for scanner.Scan() {
+		commit, err := parseCommit(scanner.Text())
+		if err != nil {
+			walkErr = err
+			break
+		}
+
+		if !callback(commit) {
+			stopped = true
+			break
+		}
+	}
+
+	// do not wait for git to write the remaining history
+	if stopped || walkErr != nil {
+		cancel()
+		_, _ = io.Copy(io.Discard, stdout)
+		_ = cmd.Wait()
+
+		return walkErr
+	}
+
+	if err := scanner.Err(); err != nil {
+		_ = cmd.Wait()
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func splitCommits(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.Index(data, []byte(commitSeparator)); i >= 0 {
+		return i + len(commitSeparator), data[:i], nil
+	}
+
+	if atEOF && len(bytes.TrimSpace(data)) > 0 {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
+
+func parseCommit(record string) (types.Commit, error) {
+	fields := strings.SplitN(strings.TrimLeft(record, "\n"), fieldSeparator, 3)
+	if len(fields) != 3 {
+		return types.Commit{}, fmt.Errorf("unexpected log output %q", record)
+	}
+
+	return types.Commit{
+		Hash:    fields[0],
+		Title:   fields[1],
+		Message: strings.TrimSpace(fields[2]),
+		PullRequest: types.PullRequest{
+			Number: types.PullRequestNumber(fields[1]),
+		},
+	}, nil
+}
+
+func (r *Repository) command(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", r.path}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "LC_ALL=C")
+
+	return cmd
+}
+
+func (r *Repository) git(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := r.command(ctx, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"context"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+// newTestRepository creates a git repository with the following first-parent history
+// on the main branch (newest first):
+//
+//	Fix the thing (#3)
+//	Merge pull request #2 from kubermatic/feature (second parent: "Work on feature")
+//	Add feature (#1)                                (tagged as v1.0.0, release/v1.0)
+//	Initial commit
+func newTestRepository(t *testing.T) (string, map[string]string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary is not available")
+	}
+
+	dir := t.TempDir()
+	hashes := map[string]string{}
+
+	run := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(cmd.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com",
+			"GIT_CONFIG_NOSYSTEM=1", "HOME="+dir,
+		)
+
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, output)
+		}
+
+		return strings.TrimSpace(string(output))
+	}
+
+	commit := func(title string) {
+		run("commit", "--allow-empty", "-m", title)
+		hashes[title] = run("rev-parse", "HEAD")
+	}
+
+	run("init", "--initial-branch=main")
+	commit("Initial commit")
+	commit("Add feature (#1)")
+	run("branch", "release/v1.0")
+	run("tag", "-a", "v1.0.0", "-m", "v1.0.0")
+
+	run("checkout", "-b", "feature")
+	commit("Work on feature")
+	run("checkout", "main")
+	run("merge", "--no-ff", "-m", "Merge pull request #2 from kubermatic/feature", "feature")
+	hashes["Merge pull request #2 from kubermatic/feature"] = run("rev-parse", "HEAD")
+	run("branch", "-D", "feature")
+
+	commit("Fix the thing (#3)")
+
+	return dir, hashes
+}
+
+func TestReferences(t *testing.T) {
+	dir, hashes := newTestRepository(t)
+
+	repo, err := NewRepository(logrus.New(), dir, source.NewMemory("main"))
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	refs, err := repo.References(context.Background(), "", "")
+	if err != nil {
+		t.Fatalf("Failed to list references: %v", err)
+	}
+
+	if refs.DefaultBranch != "main" {
+		t.Errorf("Expected default branch %q, got %q.", "main", refs.DefaultBranch)
+	}
+
+	expectedBranches := []types.Ref{
+		{Name: "main", Hash: hashes["Fix the thing (#3)"]},
+		{Name: "release/v1.0", Hash: hashes["Add feature (#1)"]},
+	}
+	if !slices.Equal(expectedBranches, refs.Branches) {
+		t.Errorf("Expected branches %v, got %v.", expectedBranches, refs.Branches)
+	}
+
+	// the annotated tag must resolve to the commit, not the tag object
+	expectedTags := []types.Ref{
+		{Name: "v1.0.0", Hash: hashes["Add feature (#1)"]},
+	}
+	if !slices.Equal(expectedTags, refs.Tags) {
+		t.Errorf("Expected tags %v, got %v.", expectedTags, refs.Tags)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	dir, hashes := newTestRepository(t)
+
+	forge := source.NewMemory("main")
+	for i := 1; i <= 3; i++ {
+		forge.AddPullRequest(types.PullRequest{
+			Number: i,
+			Body:   "body",
+			Author: "author",
+		})
+	}
+
+	repo, err := NewRepository(logrus.New(), dir, forge)
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	stopAt := hashes["Add feature (#1)"]
+	commits, err := repo.History(context.Background(), "", "", types.Range{
+		Head: "main",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == stopAt, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	expected := []string{
+		hashes["Fix the thing (#3)"],
+		hashes["Merge pull request #2 from kubermatic/feature"],
+	}
+
+	result := []string{}
+	for _, commit := range commits {
+		result = append(result, commit.Hash)
+
+		if commit.PullRequest.Body != "body" || commit.Author != "author" {
+			t.Errorf("Commit %s has no pull request details attached: %+v", commit.Hash, commit)
+		}
+	}
+
+	if !slices.Equal(expected, result) {
+		t.Fatalf("Expected commits %v, got %v.", expected, result)
+	}
+}
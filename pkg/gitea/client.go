@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/source"
+
+	"github.com/sirupsen/logrus"
+)
+
+// pageSize is the number of items requested per page. Gitea caps this at
+// the instance's MAX_RESPONSE_ITEMS, which defaults to 50, so pages can be
+// shorter and only an empty page marks the end of a list.
+const pageSize = 50
+
+// errNotFound is returned by get for 404 responses.
+var errNotFound = errors.New("not found")
+
+// Client talks to the Gitea REST API (v1), which is also provided by Forgejo.
+type Client struct {
+	baseURL *url.URL
+	token   string
+	client  *http.Client
+	log     logrus.FieldLogger
+
+	// concurrency limits the number of requests that are sent at the same
+	// time when fetching details for many commits or pull requests.
+	concurrency int
+}
+
+var _ source.Source = &Client{}
+
+// NewClient creates a new client for the Gitea/Forgejo instance at the given
+// URL (e.g. "https://git.example.com"), without the "/api/v1" suffix. Up to
+// concurrency requests are sent at the same time (parallel.DefaultConcurrency
+// if not positive).
+func NewClient(log logrus.FieldLogger, giteaURL string, token string, concurrency int) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(giteaURL, "/") + "/api/v1/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Gitea URL: %w", err)
+	}
+
+	c := &Client{
+		baseURL: base,
+		token:   token,
+		client:  http.DefaultClient,
+		log:     log,
+
+		concurrency: concurrency,
+	}
+
+	if c.concurrency <= 0 {
+		c.concurrency = parallel.DefaultConcurrency
+	}
+
+	return c, nil
+}
+
+func repoPath(owner string, name string) string {
+	return "repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
+
+// get performs a GET request against the API path and decodes the response into dst.
+func (c *Client) get(ctx context.Context, path string, query url.Values, dst interface{}) error {
+	endpoint, err := c.baseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	endpoint.RawQuery = query.Encode()
+
+	c.log.WithField("url", endpoint.String()).Debug("GET")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "token "+c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("non-200 OK status code: %v body: %q", resp.Status, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// pageQuery returns the query parameters for the given (1-based) page.
+func pageQuery(page int) url.Values {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(pageSize))
+
+	return query
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestReferences(t *testing.T) {
+	client := newTestClient(t)
+
+	refs, err := client.References(context.Background(), "kubermatic", "gchl")
+	if err != nil {
+		t.Fatalf("Failed to fetch references: %v", err)
+	}
+
+	if refs.DefaultBranch != "main" {
+		t.Errorf("Expected default branch %q, got %q.", "main", refs.DefaultBranch)
+	}
+
+	expectedBranches := []types.Ref{{Name: "main", Hash: "c70"}, {Name: "release/v1.0", Hash: "c10"}}
+	if !slices.Equal(expectedBranches, refs.Branches) {
+		t.Errorf("Expected branches %v, got %v.", expectedBranches, refs.Branches)
+	}
+
+	expectedTags := []types.Ref{{Name: "v1.0.0", Hash: "c10"}}
+	if !slices.Equal(expectedTags, refs.Tags) {
+		t.Errorf("Expected tags %v, got %v.", expectedTags, refs.Tags)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	client := newTestClient(t)
+
+	// walk across the first page boundary until we hit the tagged commit
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c70",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "c10", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	if len(commits) != 60 {
+		t.Fatalf("Expected 60 commits, got %d.", len(commits))
+	}
+
+	first := commits[0]
+	if first.Hash != "c70" || first.Title != "Commit 70" || first.Author != "user1" {
+		t.Errorf("Commit was not converted correctly: %+v", first)
+	}
+
+	if pr := first.PullRequest; pr.Number != 70 || pr.URL != "https://git.example.com/kubermatic/gchl/pulls/70" || !slices.Equal(pr.Labels, []string{"kind/bug"}) {
+		t.Errorf("Pull request was not converted correctly: %+v", pr)
+	}
+
+	if last := commits[len(commits)-1]; last.Hash != "c11" {
+		t.Errorf("Expected last commit to be c11, got %s.", last.Hash)
+	}
+}
+
+func TestHistorySkipsCommitsWithoutPullRequest(t *testing.T) {
+	client := newTestClient(t)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c4",
+		Stop: func(c types.Commit) (bool, error) {
+			return false, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	if expected := []string{"c4", "c3"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+}
+
+func TestHistoryIncludesDirectCommits(t *testing.T) {
+	client := newTestClient(t)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c4",
+		Stop: func(c types.Commit) (bool, error) {
+			return false, nil
+		},
+		IncludeDirectCommits: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	if expected := []string{"c4", "c3", "c2", "c1"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+
+	direct := commits[2]
+	if direct.HasPullRequest() || direct.Message != "Commit 2\n\nSome details." || direct.URL != "https://git.example.com/kubermatic/gchl/commit/c2" {
+		t.Errorf("Direct commit was not converted correctly: %+v", direct)
+	}
+}
+
+func TestLog(t *testing.T) {
+	client := newTestClient(t)
+
+	commits, err := client.Log(context.Background(), "kubermatic", "gchl", "c3", 10)
+	if err != nil {
+		t.Fatalf("Failed to fetch log: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	if expected := []string{"c3", "c2", "c1"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+}
+
+func TestFetchBatchPullRequests(t *testing.T) {
+	client := newTestClient(t)
+
+	prs, err := client.FetchBatchPullRequests(context.Background(), "kubermatic", "gchl", []int{5, 6, 1000})
+	if err != nil {
+		t.Fatalf("Failed to fetch pull requests: %v", err)
+	}
+
+	if len(prs) != 2 {
+		t.Fatalf("Expected 2 pull requests, got %d.", len(prs))
+	}
+
+	if pr := prs[6]; pr.Title != "PR 6" || pr.Author != "user0" {
+		t.Errorf("Pull request was not converted correctly: %+v", pr)
+	}
+}
+
+func TestHistoryFollowsFirstParents(t *testing.T) {
+	f := newFixture()
+
+	// c50 merges the side branch s1 (based on c48), which is listed between
+	// c50 and c49 as it has been committed in between
+	idx := slices.IndexFunc(f.commits, func(c commit) bool { return c.SHA == "c49" })
+
+	side := commit{SHA: "s1", Parents: []commitParent{{SHA: "c48"}}}
+	side.Commit.Message = "Side commit"
+	f.commits = slices.Insert(f.commits, idx, side)
+	f.commits[idx-1].Parents = append(f.commits[idx-1].Parents, commitParent{SHA: "s1"})
+
+	client := newFixtureClient(t, f)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c50",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "s1" || c.Hash == "c45", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	if expected := []string{"c50", "c49", "c48", "c47", "c46"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+}
+
+func TestHistoryPrefersPullRequestForBranch(t *testing.T) {
+	f := newFixture()
+
+	// c60 merged PR #60 into a feature branch, which was then merged into
+	// main via PR #200
+	feature := f.pullRequests[60]
+	feature.Base.Ref = "feature"
+	f.pullRequests[60] = feature
+
+	merge := pullRequest{Number: 200, Title: "Merge feature"}
+	merge.Base.Ref = "main"
+	f.pullRequests[200] = merge
+
+	idx := slices.IndexFunc(f.commits, func(c commit) bool { return c.SHA == "c60" })
+	f.commits[idx].Commit.Message = "Merge feature (#200)"
+
+	client := newFixtureClient(t, f)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head:   "c60",
+		Branch: "main",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "c59", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	if len(commits) != 1 || commits[0].PullRequest.Number != 200 {
+		t.Fatalf("Expected c60 to be attributed to PR #200, got %+v.", commits)
+	}
+}
+
+func TestHistoryWithSmallPages(t *testing.T) {
+	f := newFixture()
+	f.maxItems = 20
+
+	client := newFixtureClient(t, f)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c70",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "c10", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	if len(commits) != 60 {
+		t.Fatalf("Expected 60 commits, got %d.", len(commits))
+	}
+
+	refs, err := client.References(context.Background(), "kubermatic", "gchl")
+	if err != nil {
+		t.Fatalf("Failed to fetch references: %v", err)
+	}
+
+	if len(refs.Tags) != len(f.tags) {
+		t.Errorf("Expected %d tags, got %d.", len(f.tags), len(refs.Tags))
+	}
+}
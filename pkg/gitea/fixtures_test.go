@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	testToken = "s3cr3t"
+	testRepo  = "/api/v1/repos/kubermatic/gchl"
+)
+
+// fixtureServer is a minimal stand-in for the Gitea/Forgejo API, serving
+// a single repository with the following history (newest first):
+//
+//	c70 .. c3  commits merged via PR #c (e.g. c42 belongs to PR #42)
+//	c2         direct push, no pull request
+//	c1         initial commit, no pull request
+//
+// The 70 commits ensure that the commit history spans multiple pages. Like
+// Gitea, the server lists all ancestors of a commit, not just the first parents.
+type fixtureServer struct {
+	commits      []commit
+	branches     []branch
+	tags         []tag
+	pullRequests map[int]pullRequest
+	commitPRs    map[string]int
+	// maxItems caps the page size like Gitea's MAX_RESPONSE_ITEMS, if set.
+	maxItems int
+}
+
+func newFixture() *fixtureServer {
+	f := &fixtureServer{
+		pullRequests: map[int]pullRequest{},
+		commitPRs:    map[string]int{},
+	}
+
+	for i := 70; i >= 1; i-- {
+		c := commit{
+			SHA:     fmt.Sprintf("c%d", i),
+			HTMLURL: fmt.Sprintf("https://git.example.com/kubermatic/gchl/commit/c%d", i),
+		}
+		c.Commit.Message = fmt.Sprintf("Commit %d\n\nSome details.", i)
+		if i > 1 {
+			c.Parents = append(c.Parents, commitParent{SHA: fmt.Sprintf("c%d", i-1)})
+		}
+		f.commits = append(f.commits, c)
+
+		if i < 3 {
+			continue
+		}
+
+		pr := pullRequest{
+			Number:  i,
+			Title:   fmt.Sprintf("PR %d", i),
+			Body:    fmt.Sprintf("```release-note\nChange %d\n```", i),
+			HTMLURL: fmt.Sprintf("https://git.example.com/kubermatic/gchl/pulls/%d", i),
+		}
+		pr.User.Login = fmt.Sprintf("user%d", i%3)
+		pr.Labels = append(pr.Labels, struct {
+			Name string `json:"name"`
+		}{Name: "kind/bug"})
+
+		f.pullRequests[i] = pr
+		f.commitPRs[c.SHA] = i
+	}
+
+	for _, b := range []struct{ name, hash string }{{"main", "c70"}, {"release/v1.0", "c10"}} {
+		br := branch{Name: b.name}
+		br.Commit.ID = b.hash
+		f.branches = append(f.branches, br)
+	}
+
+	tg := tag{Name: "v1.0.0"}
+	tg.Commit.SHA = "c10"
+	f.tags = append(f.tags, tg)
+
+	return f
+}
+
+func newTestClient(t *testing.T) *Client {
+	return newFixtureClient(t, newFixture())
+}
+
+func newFixtureClient(t *testing.T, f *fixtureServer) *Client {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(logrus.New(), server.URL, testToken, 0)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return client
+}
+
+func (f *fixtureServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "token "+testToken {
+		http.Error(w, `{"message":"token is required"}`, http.StatusUnauthorized)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, testRepo) {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+
+	switch path := strings.TrimPrefix(r.URL.Path, testRepo); {
+	case path == "":
+		writeJSON(w, repository{DefaultBranch: "main"})
+
+	case path == "/branches":
+		writePage(w, r, f.branches, f.maxItems)
+
+	case path == "/tags":
+		writePage(w, r, f.tags, f.maxItems)
+
+	case path == "/commits":
+		start := slices.IndexFunc(f.commits, func(c commit) bool { return c.SHA == query.Get("sha") })
+		if start < 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		writePage(w, r, f.commits[start:], f.maxItems)
+
+	case strings.HasPrefix(path, "/commits/") && strings.HasSuffix(path, "/pull"):
+		hash := strings.TrimSuffix(strings.TrimPrefix(path, "/commits/"), "/pull")
+
+		number, ok := f.commitPRs[hash]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		writeJSON(w, f.pullRequests[number])
+
+	case strings.HasPrefix(path, "/pulls/"):
+		number, _ := strconv.Atoi(strings.TrimPrefix(path, "/pulls/"))
+
+		pr, ok := f.pullRequests[number]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		writeJSON(w, pr)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T, maxItems int) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if maxItems > 0 {
+		limit = min(limit, maxItems)
+	}
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+	start := min((page-1)*limit, len(items))
+	end := min(start+limit, len(items))
+
+	writeJSON(w, items[start:end])
+}
+
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type commit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+	} `json:"commit"`
+	Parents []commitParent `json:"parents"`
+}
+
+type commitParent struct {
+	SHA string `json:"sha"`
+}
+
+func (c commit) title() string {
+	title, _, _ := strings.Cut(c.Commit.Message, "\n")
+	return strings.TrimSpace(title)
+}
+
+// History will return all commits, beginning with the head hash, until the stop
+// function returns true.
+func (c *Client) History(ctx context.Context, owner string, name string, rng types.Range) ([]types.Commit, error) {
+	commits := []types.Commit{}
+	walker := c.newFirstParentWalker(owner, name, rng.Head)
+
+	for {
+		apiCommits, err := walker.next(ctx, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch commits: %w", err)
+		}
+
+		if len(apiCommits) == 0 {
+			break
+		}
+
+		prs, err := c.fetchCommitPullRequests(ctx, owner, name, apiCommits, rng.Branch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, apiCommit := range apiCommits {
+			commit := types.Commit{
+				Hash:    apiCommit.SHA,
+				Title:   apiCommit.title(),
+				Message: apiCommit.Commit.Message,
+				URL:     apiCommit.HTMLURL,
+			}
+
+			pr, hasPR := prs[apiCommit.SHA]
+			if hasPR {
+				commit.Author = pr.Author
+				commit.PullRequest = pr
+			}
+
+			stopped, err := rng.Stop(commit)
+			if err != nil {
+				return nil, err
+			}
+
+			if stopped {
+				return commits, nil
+			}
+
+			if !hasPR && !rng.IncludeDirectCommits {
+				c.log.WithField("commit", apiCommit.SHA).Warn("Commit has no associated pull request.")
+				continue
+			}
+
+			commits = append(commits, commit)
+		}
+	}
+
+	return commits, nil
+}
+
+// Log returns up to maxCommits commits of the first-parent history. The log
+// contains all commits, but no pull request details.
+func (c *Client) Log(ctx context.Context, owner string, name string, headHash string, maxCommits int) ([]types.Commit, error) {
+	apiCommits, err := c.newFirstParentWalker(owner, name, headHash).next(ctx, maxCommits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+
+	commits := []types.Commit{}
+	for _, apiCommit := range apiCommits {
+		commits = append(commits, types.Commit{
+			Hash:  apiCommit.SHA,
+			Title: apiCommit.title(),
+		})
+	}
+
+	return commits, nil
+}
+
+// firstParentWalker follows the first parents, beginning with a head commit.
+// The Gitea API only lists all ancestors of a commit (ordered by date, so
+// commits from merged branches are interleaved), so the pages are buffered
+// until the next first parent shows up.
+type firstParentWalker struct {
+	client    *Client
+	owner     string
+	name      string
+	head      string
+	nextHash  string
+	page      int
+	exhausted bool
+	buffered  map[string]commit
+}
+
+func (c *Client) newFirstParentWalker(owner string, name string, head string) *firstParentWalker {
+	return &firstParentWalker{
+		client:   c,
+		owner:    owner,
+		name:     name,
+		head:     head,
+		nextHash: head,
+		buffered: map[string]commit{},
+	}
+}
+
+// next returns up to n further commits of the first-parent history.
+func (w *firstParentWalker) next(ctx context.Context, n int) ([]commit, error) {
+	result := []commit{}
+
+	for len(result) < n && w.nextHash != "" {
+		c, ok := w.buffered[w.nextHash]
+		if !ok {
+			if w.exhausted {
+				return nil, fmt.Errorf("commit %s is not part of the history of %s", w.nextHash, w.head)
+			}
+
+			w.page++
+
+			apiCommits, err := w.client.fetchCommits(ctx, w.owner, w.name, w.head, w.page)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, apiCommit := range apiCommits {
+				w.buffered[apiCommit.SHA] = apiCommit
+			}
+
+			w.exhausted = len(apiCommits) == 0
+
+			continue
+		}
+
+		delete(w.buffered, w.nextHash)
+		result = append(result, c)
+
+		w.nextHash = ""
+		if len(c.Parents) > 0 {
+			w.nextHash = c.Parents[0].SHA
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Client) fetchCommits(ctx context.Context, owner string, name string, headHash string, page int) ([]commit, error) {
+	query := pageQuery(page)
+	query.Set("sha", headHash)
+
+	// skip expensive details we do not need
+	query.Set("stat", "false")
+	query.Set("verification", "false")
+	query.Set("files", "false")
+
+	c.log.WithField("page", page).Debug("fetchCommits()")
+
+	var commits []commit
+	if err := c.get(ctx, repoPath(owner, name)+"/commits", query, &commits); err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// fetchCommitPullRequests returns the pull requests that were merged with
+// the given commits. Commits without a pull request are not included in the
+// result. If a pull request was not merged into the branch (e.g. into a feature
+// branch that was merged later), but the commit title names one that was,
+// the latter is used.
+func (c *Client) fetchCommitPullRequests(ctx context.Context, owner string, name string, commits []commit, branch string) (map[string]types.PullRequest, error) {
+	var lock sync.Mutex
+
+	result := map[string]types.PullRequest{}
+
+	err := parallel.ForEach(ctx, c.concurrency, commits, func(ctx context.Context, apiCommit commit) error {
+		pr, err := c.fetchCommitPullRequest(ctx, owner, name, apiCommit.SHA)
+		if err != nil {
+			return fmt.Errorf("failed to fetch pull request for commit %s: %w", apiCommit.SHA, err)
+		}
+
+		if pr == nil {
+			return nil
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		result[apiCommit.SHA] = *pr
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == "" {
+		return result, nil
+	}
+
+	titleNumbers := map[string]int{}
+	numbers := sets.New[int]()
+
+	for _, apiCommit := range commits {
+		pr, ok := result[apiCommit.SHA]
+		if !ok || pr.BaseBranch == branch {
+			continue
+		}
+
+		if number := types.PullRequestNumber(apiCommit.title()); number != 0 && number != pr.Number {
+			titleNumbers[apiCommit.SHA] = number
+			numbers.Insert(number)
+		}
+	}
+
+	if len(titleNumbers) == 0 {
+		return result, nil
+	}
+
+	titlePRs, err := c.FetchBatchPullRequests(ctx, owner, name, sets.List(numbers))
+	if err != nil {
+		return nil, err
+	}
+
+	for hash, number := range titleNumbers {
+		if pr, ok := titlePRs[number]; ok && pr.BaseBranch == branch {
+			c.log.WithFields(logrus.Fields{
+				"commit": hash,
+				"branch": branch,
+				"pr":     number,
+			}).Debug("Using pull request from commit title, as it matches the branch.")
+
+			result[hash] = pr
+		}
+	}
+
+	return result, nil
+}
+
+// fetchCommitPullRequest returns the pull request that was merged with the
+// given commit, or nil if there is none.
+func (c *Client) fetchCommitPullRequest(ctx context.Context, owner string, name string, hash string) (*types.PullRequest, error) {
+	var pr pullRequest
+
+	c.log.WithField("commit", hash).Debug("fetchCommitPullRequest()")
+
+	err := c.get(ctx, repoPath(owner, name)+"/commits/"+hash+"/pull", nil, &pr)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := convertPullRequest(pr)
+
+	return &result, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type pullRequest struct {
+	Number   int        `json:"number"`
+	Title    string     `json:"title"`
+	Body     string     `json:"body"`
+	HTMLURL  string     `json:"html_url"`
+	MergedAt *time.Time `json:"merged_at"`
+	User     struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	MergedBy *struct {
+		Login string `json:"login"`
+	} `json:"merged_by"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+	Head struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+}
+
+// FetchBatchPullRequests fetches the given pull requests. The Gitea API has no
+// way to fetch multiple pull requests by number, so they are fetched one by one
+// (but concurrently).
+func (c *Client) FetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+	var lock sync.Mutex
+
+	result := map[int]types.PullRequest{}
+
+	err := parallel.ForEach(ctx, c.concurrency, numbers, func(ctx context.Context, number int) error {
+		c.log.WithField("pr", number).Debug("fetchPullRequest()")
+
+		var pr pullRequest
+
+		err := c.get(ctx, repoPath(owner, name)+"/pulls/"+strconv.Itoa(number), nil, &pr)
+		if errors.Is(err, errNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch pull request #%d: %w", number, err)
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		result[pr.Number] = convertPullRequest(pr)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func convertPullRequest(api pullRequest) types.PullRequest {
+	labels := sets.New[string]()
+	for _, label := range api.Labels {
+		labels.Insert(label.Name)
+	}
+
+	pr := types.PullRequest{
+		Number:     api.Number,
+		Title:      api.Title,
+		Body:       api.Body,
+		Author:     api.User.Login,
+		AuthorType: types.GuessAuthorType(api.User.Login),
+		URL:        api.HTMLURL,
+		Labels:     sets.List(labels),
+		MergedAt:   api.MergedAt,
+		BaseBranch: api.Base.Ref,
+		HeadBranch: api.Head.Ref,
+	}
+
+	if api.MergedBy != nil {
+		pr.MergedBy = api.MergedBy.Login
+	}
+
+	if api.Milestone != nil {
+		pr.Milestone = api.Milestone.Title
+	}
+
+	return pr
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+type repository struct {
+	DefaultBranch string `json:"default_branch"`
+}
+
+type branch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+type tag struct {
+	Name   string `json:"name"`
+	Commit struct {
+		SHA string `json:"sha"`
+	} `json:"commit"`
+}
+
+func (c *Client) References(ctx context.Context, owner string, name string) (types.RepositoryRefs, error) {
+	result := types.RepositoryRefs{}
+
+	var repo repository
+	if err := c.get(ctx, repoPath(owner, name), nil, &repo); err != nil {
+		return result, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	result.DefaultBranch = repo.DefaultBranch
+
+	for page := 1; ; page++ {
+		var branches []branch
+		if err := c.get(ctx, repoPath(owner, name)+"/branches", pageQuery(page), &branches); err != nil {
+			return result, fmt.Errorf("failed to fetch branches: %w", err)
+		}
+
+		for _, b := range branches {
+			result.Branches = append(result.Branches, types.Ref{Name: b.Name, Hash: b.Commit.ID})
+		}
+
+		if len(branches) == 0 {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		var tags []tag
+		if err := c.get(ctx, repoPath(owner, name)+"/tags", pageQuery(page), &tags); err != nil {
+			return result, fmt.Errorf("failed to fetch tags: %w", err)
+		}
+
+		for _, t := range tags {
+			result.Tags = append(result.Tags, types.Ref{Name: t.Name, Hash: t.Commit.SHA})
+		}
+
+		if len(tags) == 0 {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// jwtLifetime is the lifetime of the JWTs used to request installation
+	// tokens; GitHub allows at most 10 minutes.
+	jwtLifetime = 9 * time.Minute
+
+	// tokenEarlyExpiry is how long before the installation token expires
+	// a new one is requested, so that no request is made with a token that
+	// expires while in flight.
+	tokenEarlyExpiry = 5 * time.Minute
+)
+
+// AppCredentials are used to authenticate as a GitHub App installation.
+type AppCredentials struct {
+	AppID          int64
+	InstallationID int64
+	// PrivateKey is the PEM encoded private key of the app.
+	PrivateKey []byte
+}
+
+// appTokenSource creates installation access tokens by signing a JWT with the
+// app's private key and exchanging it via the REST API. It does not cache the
+// tokens itself, wrap it in an oauth2.ReuseTokenSource for that.
+type appTokenSource struct {
+	ctx      context.Context
+	client   *http.Client
+	endpoint string
+	appID    int64
+	key      *rsa.PrivateKey
+	log      logrus.FieldLogger
+}
+
+func newAppTokenSource(ctx context.Context, log logrus.FieldLogger, restURL string, creds AppCredentials) (oauth2.TokenSource, error) {
+	if creds.AppID == 0 {
+		return nil, errors.New("app ID cannot be empty")
+	}
+
+	if creds.InstallationID == 0 {
+		return nil, errors.New("installation ID cannot be empty")
+	}
+
+	key, err := parsePrivateKey(creds.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	src := &appTokenSource{
+		ctx:      ctx,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		endpoint: fmt.Sprintf("%s/app/installations/%d/access_tokens", restURL, creds.InstallationID),
+		appID:    creds.AppID,
+		key:      key,
+		log:      log,
+	}
+
+	return oauth2.ReuseTokenSourceWithExpiry(nil, src, tokenEarlyExpiry), nil
+}
+
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	// GitHub hands out PKCS#1 keys, but converted keys are usually PKCS#8
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("expected RSA key, got %T", parsed)
+	}
+
+	return key, nil
+}
+
+// jwt returns a signed JSON Web Token that authenticates as the app itself.
+func (s *appTokenSource) jwt(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		// backdate to allow for clock drift
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+		"iss": strconv.FormatInt(s.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	unsigned := encoding.EncodeToString(header) + "." + encoding.EncodeToString(claims)
+
+	hash := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + encoding.EncodeToString(signature), nil
+}
+
+type installationToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	s.log.Debug("Requesting new GitHub App installation token…")
+
+	jwt, err := s.jwt(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request installation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to request installation token: %v: %q", resp.Status, body)
+	}
+
+	var token installationToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode installation token: %w", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		Expiry:      token.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// newAppServer returns a stand-in for the GitHub REST API that hands out
+// installation tokens for installation 42, each valid for the given duration.
+func newAppServer(t *testing.T, key *rsa.PrivateKey, validity time.Duration) (*httptest.Server, *atomic.Int32) {
+	issued := &atomic.Int32{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/app/installations/42/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		jwt, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			http.Error(w, "missing JWT", http.StatusUnauthorized)
+			return
+		}
+
+		if err := verifyJWT(jwt, &key.PublicKey); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		number := issued.Add(1)
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(installationToken{
+			Token:     fmt.Sprintf("token-%d", number),
+			ExpiresAt: time.Now().Add(validity),
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, issued
+}
+
+func verifyJWT(jwt string, key *rsa.PublicKey) error {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed JWT %q", jwt)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return err
+	}
+
+	var claims struct {
+		Issuer    string `json:"iss"`
+		ExpiresAt int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return err
+	}
+
+	if claims.Issuer != "1234" {
+		return fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+
+	if time.Unix(claims.ExpiresAt, 0).Before(time.Now()) {
+		return fmt.Errorf("JWT has expired")
+	}
+
+	return nil
+}
+
+func newPrivateKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	encoded := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	return key, encoded
+}
+
+func TestAppTokenSource(t *testing.T) {
+	key, encoded := newPrivateKey(t)
+	server, issued := newAppServer(t, key, time.Hour)
+
+	src, err := newAppTokenSource(context.Background(), logrus.New(), RESTEndpoint(server.URL), AppCredentials{
+		AppID:          1234,
+		InstallationID: 42,
+		PrivateKey:     encoded,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create token source: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		token, err := src.Token()
+		if err != nil {
+			t.Fatalf("Failed to get token: %v", err)
+		}
+
+		if token.AccessToken != "token-1" {
+			t.Fatalf("Expected the first token to be reused, got %q.", token.AccessToken)
+		}
+	}
+
+	if n := issued.Load(); n != 1 {
+		t.Fatalf("Expected 1 token exchange, got %d.", n)
+	}
+}
+
+func TestAppTokenSourceRefreshesExpiringTokens(t *testing.T) {
+	key, encoded := newPrivateKey(t)
+
+	// tokens that expire within the early expiry window must be replaced
+	server, issued := newAppServer(t, key, tokenEarlyExpiry/2)
+
+	src, err := newAppTokenSource(context.Background(), logrus.New(), RESTEndpoint(server.URL), AppCredentials{
+		AppID:          1234,
+		InstallationID: 42,
+		PrivateKey:     encoded,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create token source: %v", err)
+	}
+
+	for i := 1; i <= 2; i++ {
+		token, err := src.Token()
+		if err != nil {
+			t.Fatalf("Failed to get token: %v", err)
+		}
+
+		if expected := fmt.Sprintf("token-%d", i); token.AccessToken != expected {
+			t.Fatalf("Expected %q, got %q.", expected, token.AccessToken)
+		}
+	}
+
+	if n := issued.Load(); n != 2 {
+		t.Fatalf("Expected 2 token exchanges, got %d.", n)
+	}
+}
+
+func TestAppTokenSourceRejectsInvalidKey(t *testing.T) {
+	_, err := newAppTokenSource(context.Background(), logrus.New(), "http://localhost", AppCredentials{
+		AppID:          1234,
+		InstallationID: 42,
+		PrivateKey:     []byte("not a key"),
+	})
+	if err == nil {
+		t.Fatal("Expected an error, but got none.")
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8c.io/gchl/pkg/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// diskCache persists GraphQL results across runs. Commits are immutable, so
+// their pull request associations never need to be refetched. Pull requests
+// can change and are stored alongside their updatedAt timestamp, so they can
+// be revalidated cheaply. The layout is
+//
+//	<dir>/<version>/<owner>/<name>/commits/<hash>.json
+//	<dir>/<version>/<owner>/<name>/pulls/<number>.json
+type diskCache struct {
+	dir string
+
+	// validated are the pull requests (per repository) that have been checked
+	// to be up-to-date during this run and need no further revalidation.
+	lock      sync.Mutex
+	validated map[string]sets.Set[int]
+}
+
+// cacheVersion must be increased whenever the cached data changes (e.g. when
+// fields are added to graphqlPullRequest), so that outdated entries are ignored.
+const cacheVersion = "v7"
+
+type cachedCommit struct {
+	PullRequests []int `json:"pullRequests"`
+}
+
+// cachedPullRequest is a pull request together with the issues it closes,
+// which are fetched separately.
+type cachedPullRequest struct {
+	PullRequest graphqlPullRequest `json:"pullRequest"`
+	// Issues is nil until the issues have been linked.
+	Issues []types.Issue `json:"issues"`
+}
+
+func newDiskCache(dir string) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &diskCache{
+		dir:       dir,
+		validated: map[string]sets.Set[int]{},
+	}, nil
+}
+
+func (c *diskCache) repoDir(owner string, name string) string {
+	return filepath.Join(c.dir, cacheVersion, strings.ToLower(owner), strings.ToLower(name))
+}
+
+// commit returns the numbers of the pull requests associated with the commit.
+func (c *diskCache) commit(owner string, name string, hash string) ([]int, bool) {
+	var cached cachedCommit
+	if !c.read(filepath.Join(c.repoDir(owner, name), "commits", hash+".json"), &cached) {
+		return nil, false
+	}
+
+	return cached.PullRequests, true
+}
+
+func (c *diskCache) storeCommit(owner string, name string, commit commitSchema) error {
+	cached := cachedCommit{
+		PullRequests: []int{},
+	}
+
+	for _, pr := range commit.AssociatedPullRequests.Nodes {
+		cached.PullRequests = append(cached.PullRequests, pr.Number)
+	}
+
+	return c.write(filepath.Join(c.repoDir(owner, name), "commits", commit.OID+".json"), cached)
+}
+
+// pullRequest returns the cached pull request, regardless of whether it has
+// been revalidated or not.
+func (c *diskCache) pullRequest(owner string, name string, number int) (graphqlPullRequest, bool) {
+	var cached cachedPullRequest
+	ok := c.read(c.pullRequestFile(owner, name, number), &cached)
+
+	return cached.PullRequest, ok
+}
+
+// storePullRequest writes the pull request to disk and marks it as validated.
+// Its issues have to be linked again.
+func (c *diskCache) storePullRequest(owner string, name string, pr graphqlPullRequest) error {
+	if err := c.write(c.pullRequestFile(owner, name, pr.Number), cachedPullRequest{PullRequest: pr}); err != nil {
+		return err
+	}
+
+	c.markValidated(owner, name, pr.Number)
+
+	return nil
+}
+
+// pullRequestIssues returns the cached issues closed by the pull request, if
+// they have been linked already.
+func (c *diskCache) pullRequestIssues(owner string, name string, number int) ([]types.Issue, bool) {
+	var cached cachedPullRequest
+	if !c.read(c.pullRequestFile(owner, name, number), &cached) || cached.Issues == nil {
+		return nil, false
+	}
+
+	return cached.Issues, true
+}
+
+// storePullRequestIssues adds the issues closed by the pull request to its
+// cache entry. Nothing is stored if the pull request itself is not cached.
+func (c *diskCache) storePullRequestIssues(owner string, name string, number int, issues []types.Issue) error {
+	var cached cachedPullRequest
+	if !c.read(c.pullRequestFile(owner, name, number), &cached) {
+		return nil
+	}
+
+	// an empty list marks the issues as linked
+	cached.Issues = append([]types.Issue{}, issues...)
+
+	return c.write(c.pullRequestFile(owner, name, number), cached)
+}
+
+func (c *diskCache) pullRequestFile(owner string, name string, number int) string {
+	return filepath.Join(c.repoDir(owner, name), "pulls", strconv.Itoa(number)+".json")
+}
+
+func (c *diskCache) markValidated(owner string, name string, number int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := owner + "/" + name
+	if _, ok := c.validated[key]; !ok {
+		c.validated[key] = sets.New[int]()
+	}
+
+	c.validated[key].Insert(number)
+}
+
+func (c *diskCache) isValidated(owner string, name string, number int) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.validated[owner+"/"+name].Has(number)
+}
+
+func (c *diskCache) read(filename string, dst interface{}) bool {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return false
+	}
+
+	// treat broken cache files as cache misses, they will be overwritten
+	return json.Unmarshal(content, dst) == nil
+}
+
+func (c *diskCache) write(filename string, data interface{}) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so that concurrent runs never
+	// see partially written files
+	tmp, err := os.CreateTemp(filepath.Dir(filename), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), filename)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+
+	return err
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
+)
+
+// fakePullRequestServer serves pull requests #1..#3 from a GraphQL endpoint,
+// counting how many full pull requests and closed issues have been returned.
+// Only PR #1 closes an issue.
+type fakePullRequestServer struct {
+	updatedAt     map[int]time.Time
+	fetched       atomic.Int32
+	fetchedIssues atomic.Int32
+}
+
+func (f *fakePullRequestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// schema check
+	if strings.Contains(req.Query, "__type(") {
+		toType := func(fields []string) map[string]interface{} {
+			result := []map[string]string{}
+			for _, field := range fields {
+				result = append(result, map[string]string{"name": field})
+			}
+
+			return map[string]interface{}{"fields": result}
+		}
+
+		writeData(w, map[string]interface{}{
+			"commit":      toType(requiredSchemaFields["Commit"]),
+			"pullRequest": toType(append(requiredSchemaFields["PullRequest"], "closingIssuesReferences")),
+		})
+		return
+	}
+
+	issues := strings.Contains(req.Query, "closingIssuesReferences")
+	full := strings.Contains(req.Query, "body")
+	repository := map[string]interface{}{}
+
+	for i := 0; i < MaxPullRequestsPerQuery; i++ {
+		if has, _ := req.Variables[fmt.Sprintf("has%d", i)].(bool); !has {
+			continue
+		}
+
+		number := int(req.Variables[fmt.Sprintf("number%d", i)].(float64))
+
+		updatedAt, ok := f.updatedAt[number]
+		if !ok {
+			repository[fmt.Sprintf("pr%d", i)] = nil
+			continue
+		}
+
+		pr := map[string]interface{}{
+			"number":    number,
+			"updatedAt": updatedAt,
+		}
+
+		if issues {
+			f.fetchedIssues.Add(1)
+
+			nodes := []interface{}{}
+			if number == 1 {
+				nodes = append(nodes, map[string]interface{}{"number": 7, "title": "Outdated dependencies"})
+			}
+
+			repository[fmt.Sprintf("pr%d", i)] = map[string]interface{}{
+				"number":                  number,
+				"closingIssuesReferences": map[string]interface{}{"nodes": nodes},
+			}
+
+			continue
+		}
+
+		if full {
+			f.fetched.Add(1)
+
+			pr["title"] = fmt.Sprintf("PR %d (%s)", number, updatedAt.Format(time.RFC3339))
+			pr["author"] = map[string]string{"login": "user"}
+			pr["labels"] = map[string]interface{}{"nodes": []interface{}{}}
+		}
+
+		repository[fmt.Sprintf("pr%d", i)] = pr
+	}
+
+	writeData(w, map[string]interface{}{"repository": repository})
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func newCachingClient(t *testing.T, serverURL string, cacheDir string) *Client {
+	client, err := NewClient(context.Background(), logrus.New(), ClientOptions{
+		URL:      serverURL,
+		Token:    "test",
+		CacheDir: cacheDir,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return client
+}
+
+// newTestClient returns a client for the GraphQL server that sends one
+// request at a time.
+func newTestClient(server *httptest.Server) *Client {
+	return &Client{
+		client:      githubv4.NewEnterpriseClient(server.URL, http.DefaultClient),
+		limiter:     newRateLimiter(logrus.New()),
+		log:         logrus.New(),
+		concurrency: 1,
+	}
+}
+
+func TestCachedPullRequests(t *testing.T) {
+	ctx := context.Background()
+	cacheDir := t.TempDir()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	fake := &fakePullRequestServer{
+		updatedAt: map[int]time.Time{
+			1: now,
+			2: now,
+			3: now,
+		},
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	// first run populates the cache
+	if _, err := newCachingClient(t, server.URL, cacheDir).FetchBatchPullRequests(ctx, "kubermatic", "gchl", []int{1, 2}); err != nil {
+		t.Fatalf("Failed to fetch pull requests: %v", err)
+	}
+
+	if n := fake.fetched.Load(); n != 2 {
+		t.Fatalf("Expected 2 pull requests to be fetched, got %d.", n)
+	}
+
+	if n := fake.fetchedIssues.Load(); n != 2 {
+		t.Fatalf("Expected issues of 2 pull requests to be fetched, got %d.", n)
+	}
+
+	// PR #2 changes in the meantime
+	fake.updatedAt[2] = now.Add(time.Hour)
+	fake.fetched.Store(0)
+	fake.fetchedIssues.Store(0)
+
+	// second run should only fetch the modified and the new pull request
+	prs, err := newCachingClient(t, server.URL, cacheDir).FetchBatchPullRequests(ctx, "kubermatic", "gchl", []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to fetch pull requests: %v", err)
+	}
+
+	if n := fake.fetched.Load(); n != 2 {
+		t.Fatalf("Expected 2 pull requests to be fetched, got %d.", n)
+	}
+
+	if n := fake.fetchedIssues.Load(); n != 2 {
+		t.Fatalf("Expected issues of 2 pull requests to be fetched, got %d.", n)
+	}
+
+	if len(prs) != 3 {
+		t.Fatalf("Expected 3 pull requests, got %d.", len(prs))
+	}
+
+	if issues := prs[1].Issues; len(issues) != 1 || issues[0].Number != 7 {
+		t.Errorf("Expected PR #1 to close issue #7 from the cache, got %v.", issues)
+	}
+
+	if expected := fmt.Sprintf("PR 2 (%s)", now.Add(time.Hour).Format(time.RFC3339)); prs[2].Title != expected {
+		t.Errorf("Expected modified pull request to be refetched, got title %q.", prs[2].Title)
+	}
+}
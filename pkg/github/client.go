@@ -20,34 +20,224 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/source"
 
 	"github.com/shurcooL/githubv4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
 )
 
+// DefaultURL is the web URL of github.com.
+const DefaultURL = "https://github.com"
+
 type Client struct {
-	client *githubv4.Client
-	log    logrus.FieldLogger
+	client      *githubv4.Client
+	httpClient  *http.Client
+	restURL     string
+	cache       *diskCache
+	limiter     *rateLimiter
+	concurrency int
+	log         logrus.FieldLogger
+
+	// closingIssues is false if the API does not support closing issue
+	// references, in which case no issues are linked to pull requests.
+	closingIssues bool
+
+	// changedFiles is false if the API does not support listing the files
+	// changed by pull requests.
+	changedFiles bool
+}
+
+var _ source.Source = &Client{}
+
+type ClientOptions struct {
+	// URL is the web URL of the GitHub instance, e.g. "https://github.example.com"
+	// for a GitHub Enterprise Server. Defaults to DefaultURL.
+	URL string
+
+	// Token is a personal access token. Either Token or App must be set.
+	Token string
+
+	// App are the credentials to authenticate as a GitHub App installation.
+	App *AppCredentials
+
+	// CacheDir is an optional directory where API results are persisted
+	// across runs.
+	CacheDir string
+
+	// RecordDir is an optional directory where all GraphQL requests and
+	// their responses are saved as fixtures.
+	RecordDir string
+
+	// ReplayDir is an optional directory containing previously recorded
+	// fixtures. When set, no network requests are made and no credentials
+	// are required.
+	ReplayDir string
+
+	// RequestTimeout limits the duration of each individual request. Failed
+	// requests are retried, so this is not the overall timeout.
+	RequestTimeout time.Duration
+
+	// Concurrency is the maximum number of queries that are run at the same
+	// time. All of them share the same rate limit. Defaults to parallel.DefaultConcurrency.
+	Concurrency int
+}
+
+func NewClient(ctx context.Context, log logrus.FieldLogger, opts ClientOptions) (*Client, error) {
+	if opts.RecordDir != "" && opts.ReplayDir != "" {
+		return nil, errors.New("cannot record and replay at the same time")
+	}
+
+	var httpClient *http.Client
+
+	if opts.ReplayDir != "" {
+		httpClient = &http.Client{
+			Transport: &replayTransport{dir: opts.ReplayDir},
+		}
+	} else {
+		src, err := newTokenSource(ctx, log, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient = oauth2.NewClient(ctx, src)
+		httpClient.Transport = newRetryTransport(httpClient.Transport, log, opts.RequestTimeout)
+	}
+
+	if opts.RecordDir != "" {
+		transport, err := newRecordingTransport(httpClient.Transport, opts.RecordDir)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient.Transport = transport
+	}
+
+	var client *githubv4.Client
+	if isGitHubDotCom(opts.URL) {
+		client = githubv4.NewClient(httpClient)
+	} else {
+		client = githubv4.NewEnterpriseClient(GraphQLEndpoint(opts.URL), httpClient)
+	}
+
+	c := &Client{
+		client:      client,
+		httpClient:  httpClient,
+		restURL:     RESTEndpoint(opts.URL),
+		limiter:     newRateLimiter(log),
+		concurrency: opts.Concurrency,
+		log:         log,
+
+		closingIssues: true,
+		changedFiles:  true,
+	}
+
+	if c.concurrency <= 0 {
+		c.concurrency = parallel.DefaultConcurrency
+	}
+
+	if opts.CacheDir != "" {
+		cache, err := newDiskCache(opts.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache = cache
+	}
+
+	// GitHub Enterprise Server lags behind github.com and might not support
+	// everything we need; better to find out now than in the middle of a run.
+	if !isGitHubDotCom(opts.URL) {
+		if err := c.checkSchema(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
 }
 
-func NewClient(ctx context.Context, log logrus.FieldLogger, token string) (*Client, error) {
-	if token == "" {
+// newTokenSource returns the token source for authenticating against the
+// GitHub API, either as a GitHub App installation or using a static token.
+func newTokenSource(ctx context.Context, log logrus.FieldLogger, opts ClientOptions) (oauth2.TokenSource, error) {
+	var src oauth2.TokenSource
+
+	switch {
+	case opts.App != nil:
+		var err error
+
+		src, err = newAppTokenSource(ctx, log, RESTEndpoint(opts.URL), *opts.App)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GitHub App credentials: %w", err)
+		}
+
+	case opts.Token != "":
+		src = oauth2.StaticTokenSource(
+			&oauth2.Token{
+				AccessToken: opts.Token,
+			},
+		)
+
+	default:
 		return nil, errors.New("token cannot be empty")
 	}
 
-	src := oauth2.StaticTokenSource(
-		&oauth2.Token{
-			AccessToken: token,
-		},
-	)
-	httpClient := oauth2.NewClient(ctx, src)
-	client := githubv4.NewClient(httpClient)
-
-	return &Client{
-		client: client,
-		log:    log,
-	}, nil
+	return src, nil
+}
+
+func isGitHubDotCom(webURL string) bool {
+	return webURL == "" || strings.TrimSuffix(webURL, "/") == DefaultURL
+}
+
+// GraphQLEndpoint returns the GraphQL API endpoint for the GitHub instance
+// with the given web URL.
+func GraphQLEndpoint(webURL string) string {
+	if isGitHubDotCom(webURL) {
+		return "https://api.github.com/graphql"
+	}
+
+	return strings.TrimSuffix(webURL, "/") + "/api/graphql"
+}
+
+// RESTEndpoint returns the REST API base URL (without trailing slash) for
+// the GitHub instance with the given web URL.
+func RESTEndpoint(webURL string) string {
+	if isGitHubDotCom(webURL) {
+		return "https://api.github.com"
+	}
+
+	return strings.TrimSuffix(webURL, "/") + "/api/v3"
+}
+
+// emptyHash is used for unused variables in hashed queries, as the
+// variables must still be valid object IDs.
+const emptyHash = "0000000000000000000000000000000000000000"
+
+func getHashedQueryVariables(hashes []string, max int) map[string]interface{} {
+	if len(hashes) > max {
+		panic(fmt.Sprintf("List contains more (%d) than possible (%d) commit hashes.", len(hashes), max))
+	}
+
+	variables := map[string]interface{}{}
+
+	for i := 0; i < max; i++ {
+		hash := emptyHash
+		has := false
+
+		if i < len(hashes) {
+			hash = hashes[i]
+			has = true
+		}
+
+		variables[fmt.Sprintf("oid%d", i)] = githubv4.GitObjectID(hash)
+		variables[fmt.Sprintf("has%d", i)] = githubv4.Boolean(has)
+	}
+
+	return variables
 }
 
 func getNumberedQueryVariables(numbers []int, max int) map[string]interface{} {
@@ -0,0 +1,338 @@
+// This file has been generated by hack/generate-client.go
+// Do not edit manually!
+
+package github
+
+import (
+	"fmt"
+)
+
+const (
+	MaxCommitsPerQuery = 100
+)
+
+type hashedCommitQuery struct {
+	rateLimited
+
+	Repository struct {
+		C0  *commitObject `graphql:"c0: object(oid: $oid0) @include(if: $has0)"`
+		C1  *commitObject `graphql:"c1: object(oid: $oid1) @include(if: $has1)"`
+		C2  *commitObject `graphql:"c2: object(oid: $oid2) @include(if: $has2)"`
+		C3  *commitObject `graphql:"c3: object(oid: $oid3) @include(if: $has3)"`
+		C4  *commitObject `graphql:"c4: object(oid: $oid4) @include(if: $has4)"`
+		C5  *commitObject `graphql:"c5: object(oid: $oid5) @include(if: $has5)"`
+		C6  *commitObject `graphql:"c6: object(oid: $oid6) @include(if: $has6)"`
+		C7  *commitObject `graphql:"c7: object(oid: $oid7) @include(if: $has7)"`
+		C8  *commitObject `graphql:"c8: object(oid: $oid8) @include(if: $has8)"`
+		C9  *commitObject `graphql:"c9: object(oid: $oid9) @include(if: $has9)"`
+		C10 *commitObject `graphql:"c10: object(oid: $oid10) @include(if: $has10)"`
+		C11 *commitObject `graphql:"c11: object(oid: $oid11) @include(if: $has11)"`
+		C12 *commitObject `graphql:"c12: object(oid: $oid12) @include(if: $has12)"`
+		C13 *commitObject `graphql:"c13: object(oid: $oid13) @include(if: $has13)"`
+		C14 *commitObject `graphql:"c14: object(oid: $oid14) @include(if: $has14)"`
+		C15 *commitObject `graphql:"c15: object(oid: $oid15) @include(if: $has15)"`
+		C16 *commitObject `graphql:"c16: object(oid: $oid16) @include(if: $has16)"`
+		C17 *commitObject `graphql:"c17: object(oid: $oid17) @include(if: $has17)"`
+		C18 *commitObject `graphql:"c18: object(oid: $oid18) @include(if: $has18)"`
+		C19 *commitObject `graphql:"c19: object(oid: $oid19) @include(if: $has19)"`
+		C20 *commitObject `graphql:"c20: object(oid: $oid20) @include(if: $has20)"`
+		C21 *commitObject `graphql:"c21: object(oid: $oid21) @include(if: $has21)"`
+		C22 *commitObject `graphql:"c22: object(oid: $oid22) @include(if: $has22)"`
+		C23 *commitObject `graphql:"c23: object(oid: $oid23) @include(if: $has23)"`
+		C24 *commitObject `graphql:"c24: object(oid: $oid24) @include(if: $has24)"`
+		C25 *commitObject `graphql:"c25: object(oid: $oid25) @include(if: $has25)"`
+		C26 *commitObject `graphql:"c26: object(oid: $oid26) @include(if: $has26)"`
+		C27 *commitObject `graphql:"c27: object(oid: $oid27) @include(if: $has27)"`
+		C28 *commitObject `graphql:"c28: object(oid: $oid28) @include(if: $has28)"`
+		C29 *commitObject `graphql:"c29: object(oid: $oid29) @include(if: $has29)"`
+		C30 *commitObject `graphql:"c30: object(oid: $oid30) @include(if: $has30)"`
+		C31 *commitObject `graphql:"c31: object(oid: $oid31) @include(if: $has31)"`
+		C32 *commitObject `graphql:"c32: object(oid: $oid32) @include(if: $has32)"`
+		C33 *commitObject `graphql:"c33: object(oid: $oid33) @include(if: $has33)"`
+		C34 *commitObject `graphql:"c34: object(oid: $oid34) @include(if: $has34)"`
+		C35 *commitObject `graphql:"c35: object(oid: $oid35) @include(if: $has35)"`
+		C36 *commitObject `graphql:"c36: object(oid: $oid36) @include(if: $has36)"`
+		C37 *commitObject `graphql:"c37: object(oid: $oid37) @include(if: $has37)"`
+		C38 *commitObject `graphql:"c38: object(oid: $oid38) @include(if: $has38)"`
+		C39 *commitObject `graphql:"c39: object(oid: $oid39) @include(if: $has39)"`
+		C40 *commitObject `graphql:"c40: object(oid: $oid40) @include(if: $has40)"`
+		C41 *commitObject `graphql:"c41: object(oid: $oid41) @include(if: $has41)"`
+		C42 *commitObject `graphql:"c42: object(oid: $oid42) @include(if: $has42)"`
+		C43 *commitObject `graphql:"c43: object(oid: $oid43) @include(if: $has43)"`
+		C44 *commitObject `graphql:"c44: object(oid: $oid44) @include(if: $has44)"`
+		C45 *commitObject `graphql:"c45: object(oid: $oid45) @include(if: $has45)"`
+		C46 *commitObject `graphql:"c46: object(oid: $oid46) @include(if: $has46)"`
+		C47 *commitObject `graphql:"c47: object(oid: $oid47) @include(if: $has47)"`
+		C48 *commitObject `graphql:"c48: object(oid: $oid48) @include(if: $has48)"`
+		C49 *commitObject `graphql:"c49: object(oid: $oid49) @include(if: $has49)"`
+		C50 *commitObject `graphql:"c50: object(oid: $oid50) @include(if: $has50)"`
+		C51 *commitObject `graphql:"c51: object(oid: $oid51) @include(if: $has51)"`
+		C52 *commitObject `graphql:"c52: object(oid: $oid52) @include(if: $has52)"`
+		C53 *commitObject `graphql:"c53: object(oid: $oid53) @include(if: $has53)"`
+		C54 *commitObject `graphql:"c54: object(oid: $oid54) @include(if: $has54)"`
+		C55 *commitObject `graphql:"c55: object(oid: $oid55) @include(if: $has55)"`
+		C56 *commitObject `graphql:"c56: object(oid: $oid56) @include(if: $has56)"`
+		C57 *commitObject `graphql:"c57: object(oid: $oid57) @include(if: $has57)"`
+		C58 *commitObject `graphql:"c58: object(oid: $oid58) @include(if: $has58)"`
+		C59 *commitObject `graphql:"c59: object(oid: $oid59) @include(if: $has59)"`
+		C60 *commitObject `graphql:"c60: object(oid: $oid60) @include(if: $has60)"`
+		C61 *commitObject `graphql:"c61: object(oid: $oid61) @include(if: $has61)"`
+		C62 *commitObject `graphql:"c62: object(oid: $oid62) @include(if: $has62)"`
+		C63 *commitObject `graphql:"c63: object(oid: $oid63) @include(if: $has63)"`
+		C64 *commitObject `graphql:"c64: object(oid: $oid64) @include(if: $has64)"`
+		C65 *commitObject `graphql:"c65: object(oid: $oid65) @include(if: $has65)"`
+		C66 *commitObject `graphql:"c66: object(oid: $oid66) @include(if: $has66)"`
+		C67 *commitObject `graphql:"c67: object(oid: $oid67) @include(if: $has67)"`
+		C68 *commitObject `graphql:"c68: object(oid: $oid68) @include(if: $has68)"`
+		C69 *commitObject `graphql:"c69: object(oid: $oid69) @include(if: $has69)"`
+		C70 *commitObject `graphql:"c70: object(oid: $oid70) @include(if: $has70)"`
+		C71 *commitObject `graphql:"c71: object(oid: $oid71) @include(if: $has71)"`
+		C72 *commitObject `graphql:"c72: object(oid: $oid72) @include(if: $has72)"`
+		C73 *commitObject `graphql:"c73: object(oid: $oid73) @include(if: $has73)"`
+		C74 *commitObject `graphql:"c74: object(oid: $oid74) @include(if: $has74)"`
+		C75 *commitObject `graphql:"c75: object(oid: $oid75) @include(if: $has75)"`
+		C76 *commitObject `graphql:"c76: object(oid: $oid76) @include(if: $has76)"`
+		C77 *commitObject `graphql:"c77: object(oid: $oid77) @include(if: $has77)"`
+		C78 *commitObject `graphql:"c78: object(oid: $oid78) @include(if: $has78)"`
+		C79 *commitObject `graphql:"c79: object(oid: $oid79) @include(if: $has79)"`
+		C80 *commitObject `graphql:"c80: object(oid: $oid80) @include(if: $has80)"`
+		C81 *commitObject `graphql:"c81: object(oid: $oid81) @include(if: $has81)"`
+		C82 *commitObject `graphql:"c82: object(oid: $oid82) @include(if: $has82)"`
+		C83 *commitObject `graphql:"c83: object(oid: $oid83) @include(if: $has83)"`
+		C84 *commitObject `graphql:"c84: object(oid: $oid84) @include(if: $has84)"`
+		C85 *commitObject `graphql:"c85: object(oid: $oid85) @include(if: $has85)"`
+		C86 *commitObject `graphql:"c86: object(oid: $oid86) @include(if: $has86)"`
+		C87 *commitObject `graphql:"c87: object(oid: $oid87) @include(if: $has87)"`
+		C88 *commitObject `graphql:"c88: object(oid: $oid88) @include(if: $has88)"`
+		C89 *commitObject `graphql:"c89: object(oid: $oid89) @include(if: $has89)"`
+		C90 *commitObject `graphql:"c90: object(oid: $oid90) @include(if: $has90)"`
+		C91 *commitObject `graphql:"c91: object(oid: $oid91) @include(if: $has91)"`
+		C92 *commitObject `graphql:"c92: object(oid: $oid92) @include(if: $has92)"`
+		C93 *commitObject `graphql:"c93: object(oid: $oid93) @include(if: $has93)"`
+		C94 *commitObject `graphql:"c94: object(oid: $oid94) @include(if: $has94)"`
+		C95 *commitObject `graphql:"c95: object(oid: $oid95) @include(if: $has95)"`
+		C96 *commitObject `graphql:"c96: object(oid: $oid96) @include(if: $has96)"`
+		C97 *commitObject `graphql:"c97: object(oid: $oid97) @include(if: $has97)"`
+		C98 *commitObject `graphql:"c98: object(oid: $oid98) @include(if: $has98)"`
+		C99 *commitObject `graphql:"c99: object(oid: $oid99) @include(if: $has99)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (r *hashedCommitQuery) GetAll() []commitSchema {
+	result := []commitSchema{}
+
+	for i := 0; i < MaxCommitsPerQuery; i++ {
+		if c := r.Get(i); c != nil && c.Commit.OID != "" {
+			result = append(result, c.Commit)
+		}
+	}
+
+	return result
+}
+
+func (r *hashedCommitQuery) Get(index int) *commitObject {
+	switch index {
+	case 0:
+		return r.Repository.C0
+	case 1:
+		return r.Repository.C1
+	case 2:
+		return r.Repository.C2
+	case 3:
+		return r.Repository.C3
+	case 4:
+		return r.Repository.C4
+	case 5:
+		return r.Repository.C5
+	case 6:
+		return r.Repository.C6
+	case 7:
+		return r.Repository.C7
+	case 8:
+		return r.Repository.C8
+	case 9:
+		return r.Repository.C9
+	case 10:
+		return r.Repository.C10
+	case 11:
+		return r.Repository.C11
+	case 12:
+		return r.Repository.C12
+	case 13:
+		return r.Repository.C13
+	case 14:
+		return r.Repository.C14
+	case 15:
+		return r.Repository.C15
+	case 16:
+		return r.Repository.C16
+	case 17:
+		return r.Repository.C17
+	case 18:
+		return r.Repository.C18
+	case 19:
+		return r.Repository.C19
+	case 20:
+		return r.Repository.C20
+	case 21:
+		return r.Repository.C21
+	case 22:
+		return r.Repository.C22
+	case 23:
+		return r.Repository.C23
+	case 24:
+		return r.Repository.C24
+	case 25:
+		return r.Repository.C25
+	case 26:
+		return r.Repository.C26
+	case 27:
+		return r.Repository.C27
+	case 28:
+		return r.Repository.C28
+	case 29:
+		return r.Repository.C29
+	case 30:
+		return r.Repository.C30
+	case 31:
+		return r.Repository.C31
+	case 32:
+		return r.Repository.C32
+	case 33:
+		return r.Repository.C33
+	case 34:
+		return r.Repository.C34
+	case 35:
+		return r.Repository.C35
+	case 36:
+		return r.Repository.C36
+	case 37:
+		return r.Repository.C37
+	case 38:
+		return r.Repository.C38
+	case 39:
+		return r.Repository.C39
+	case 40:
+		return r.Repository.C40
+	case 41:
+		return r.Repository.C41
+	case 42:
+		return r.Repository.C42
+	case 43:
+		return r.Repository.C43
+	case 44:
+		return r.Repository.C44
+	case 45:
+		return r.Repository.C45
+	case 46:
+		return r.Repository.C46
+	case 47:
+		return r.Repository.C47
+	case 48:
+		return r.Repository.C48
+	case 49:
+		return r.Repository.C49
+	case 50:
+		return r.Repository.C50
+	case 51:
+		return r.Repository.C51
+	case 52:
+		return r.Repository.C52
+	case 53:
+		return r.Repository.C53
+	case 54:
+		return r.Repository.C54
+	case 55:
+		return r.Repository.C55
+	case 56:
+		return r.Repository.C56
+	case 57:
+		return r.Repository.C57
+	case 58:
+		return r.Repository.C58
+	case 59:
+		return r.Repository.C59
+	case 60:
+		return r.Repository.C60
+	case 61:
+		return r.Repository.C61
+	case 62:
+		return r.Repository.C62
+	case 63:
+		return r.Repository.C63
+	case 64:
+		return r.Repository.C64
+	case 65:
+		return r.Repository.C65
+	case 66:
+		return r.Repository.C66
+	case 67:
+		return r.Repository.C67
+	case 68:
+		return r.Repository.C68
+	case 69:
+		return r.Repository.C69
+	case 70:
+		return r.Repository.C70
+	case 71:
+		return r.Repository.C71
+	case 72:
+		return r.Repository.C72
+	case 73:
+		return r.Repository.C73
+	case 74:
+		return r.Repository.C74
+	case 75:
+		return r.Repository.C75
+	case 76:
+		return r.Repository.C76
+	case 77:
+		return r.Repository.C77
+	case 78:
+		return r.Repository.C78
+	case 79:
+		return r.Repository.C79
+	case 80:
+		return r.Repository.C80
+	case 81:
+		return r.Repository.C81
+	case 82:
+		return r.Repository.C82
+	case 83:
+		return r.Repository.C83
+	case 84:
+		return r.Repository.C84
+	case 85:
+		return r.Repository.C85
+	case 86:
+		return r.Repository.C86
+	case 87:
+		return r.Repository.C87
+	case 88:
+		return r.Repository.C88
+	case 89:
+		return r.Repository.C89
+	case 90:
+		return r.Repository.C90
+	case 91:
+		return r.Repository.C91
+	case 92:
+		return r.Repository.C92
+	case 93:
+		return r.Repository.C93
+	case 94:
+		return r.Repository.C94
+	case 95:
+		return r.Repository.C95
+	case 96:
+		return r.Repository.C96
+	case 97:
+		return r.Repository.C97
+	case 98:
+		return r.Repository.C98
+	case 99:
+		return r.Repository.C99
+	}
+
+	panic(fmt.Sprintf("Index %d out of range [0,%d] when accessing commit request", index, MaxCommitsPerQuery-1))
+}
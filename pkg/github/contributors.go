@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/source"
+
+	"github.com/shurcooL/githubv4"
+)
+
+var _ source.ContributorSource = &Client{}
+
+type firstPullRequestQuery struct {
+	rateLimited
+
+	Search struct {
+		Nodes []struct {
+			PullRequest struct {
+				Number    int
+				CreatedAt githubv4.DateTime
+				MergedAt  githubv4.DateTime
+			} `graphql:"... on PullRequest"`
+		}
+		PageInfo pageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 20, after: $cursor)"`
+}
+
+// FirstMergedPullRequests uses the search API to find the first merged pull
+// request for every author.
+func (c *Client) FirstMergedPullRequests(ctx context.Context, owner string, name string, authors []string) (map[string]int, error) {
+	var lock sync.Mutex
+
+	result := map[string]int{}
+
+	err := parallel.ForEachChunk(ctx, c.concurrency, authors, 1, func(ctx context.Context, chunk []string) error {
+		author := chunk[0]
+
+		number, err := c.firstMergedPullRequest(ctx, owner, name, author)
+		if err != nil {
+			return fmt.Errorf("failed to search pull requests by %s: %w", author, err)
+		}
+
+		if number == 0 {
+			return nil
+		}
+
+		lock.Lock()
+		result[author] = number
+		lock.Unlock()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// firstMergedPullRequest returns the number of the pull request by the author
+// that was merged first, or 0 if the author has no merged pull requests. The
+// search cannot sort by merge date, so the results are sorted by creation date
+// and compared by their merge date instead. As no pull request can be merged
+// before it was created, the search ends once a result has been created after
+// the earliest merge found so far.
+func (c *Client) firstMergedPullRequest(ctx context.Context, owner string, name string, author string) (int, error) {
+	variables := map[string]interface{}{
+		"query":  githubv4.String(fmt.Sprintf("repo:%s/%s is:pr is:merged author:%s sort:created-asc", owner, name, author)),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var (
+		first       int
+		firstMerged time.Time
+	)
+
+	for {
+		c.log.WithField("author", author).Debug("fetchFirstPullRequest()")
+
+		var q firstPullRequestQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return 0, err
+		}
+
+		nodes := q.Search.Nodes
+		for _, node := range nodes {
+			if first == 0 || node.PullRequest.MergedAt.Before(firstMerged) {
+				first = node.PullRequest.Number
+				firstMerged = node.PullRequest.MergedAt.Time
+			}
+		}
+
+		if len(nodes) == 0 || !q.Search.PageInfo.HasNextPage || !nodes[len(nodes)-1].PullRequest.CreatedAt.Before(firstMerged) {
+			break
+		}
+
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return first, nil
+}
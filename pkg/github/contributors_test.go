@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFirstMergedPullRequests(t *testing.T) {
+	day := func(d int) string {
+		return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+
+	pr := func(number int, created int, merged int) map[string]interface{} {
+		return map[string]interface{}{"number": number, "createdAt": day(created), "mergedAt": day(merged)}
+	}
+
+	// one result per page, sorted by creation date; alice's oldest pull
+	// request was merged last, and her fourth page must not be fetched
+	pages := map[string][]map[string]interface{}{
+		"alice": {pr(10, 1, 9), pr(12, 2, 3), pr(15, 4, 5), pr(20, 6, 6)},
+		"bob":   {pr(345, 1, 2)},
+	}
+
+	var lock sync.Mutex
+	requested := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Query  string `json:"query"`
+				Cursor string `json:"cursor"`
+			} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		nodes := []interface{}{}
+		endCursor := ""
+		hasNextPage := false
+
+		for author, results := range pages {
+			if strings.Contains(req.Variables.Query, "repo:kubermatic/gchl ") && strings.Contains(req.Variables.Query, " author:"+author+" ") {
+				page, _ := strconv.Atoi(req.Variables.Cursor)
+
+				lock.Lock()
+				requested[author]++
+				lock.Unlock()
+
+				nodes = append(nodes, results[page])
+				endCursor = strconv.Itoa(page + 1)
+				hasNextPage = page+1 < len(results)
+			}
+		}
+
+		writeData(w, map[string]interface{}{
+			"search": map[string]interface{}{
+				"nodes": nodes,
+				"pageInfo": map[string]interface{}{
+					"endCursor":   endCursor,
+					"hasNextPage": hasNextPage,
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+	client.concurrency = 2
+
+	result, err := client.FirstMergedPullRequests(context.Background(), "kubermatic", "gchl", []string{"alice", "bob", "newbie"})
+	if err != nil {
+		t.Fatalf("Failed to search pull requests: %v", err)
+	}
+
+	if expected := map[string]int{"alice": 12, "bob": 345}; !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v, got %v.", expected, result)
+	}
+
+	if requested["alice"] != 3 {
+		t.Errorf("Expected 3 pages to be fetched for alice, got %d.", requested["alice"])
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/source"
+
+	"github.com/shurcooL/githubv4"
+)
+
+var _ source.FileSource = &Client{}
+
+// maxPullRequestFilesPerQuery is lower than MaxPullRequestsPerQuery, as each
+// pull request can contribute up to 100 files to the response.
+const maxPullRequestFilesPerQuery = 25
+
+type pullRequestFiles struct {
+	Number int
+	Files  struct {
+		Nodes    []graphqlFile
+		PageInfo pageInfo
+	} `graphql:"files(first: 100)"`
+}
+
+type graphqlFile struct {
+	Path string
+}
+
+func (c *Client) PullRequestFiles(ctx context.Context, owner string, name string, numbers []int) (map[int][]string, error) {
+	if !c.changedFiles {
+		return nil, errors.New("the GitHub API does not support the GraphQL field PullRequest.files, which is required for --component, please upgrade your GitHub Enterprise Server")
+	}
+
+	var lock sync.Mutex
+
+	result := map[int][]string{}
+
+	err := parallel.ForEachChunk(ctx, c.concurrency, numbers, maxPullRequestFilesPerQuery, func(ctx context.Context, chunk []int) error {
+		// the generated query always has MaxPullRequestsPerQuery fields
+		variables := getNumberedQueryVariables(chunk, MaxPullRequestsPerQuery)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(name)
+
+		c.log.WithField("prs", len(chunk)).Debug("fetchPullRequestFiles()")
+
+		var q numberedPullRequestFilesQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return err
+		}
+
+		all := q.GetAll()
+		if err := c.completeFiles(ctx, owner, name, all); err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, pr := range all {
+			paths := []string{}
+			for _, file := range pr.Files.Nodes {
+				paths = append(paths, file.Path)
+			}
+
+			result[pr.Number] = paths
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestPullRequestFiles(t *testing.T) {
+	// PR #1 changed more files than fit into the first page
+	files := map[int][]string{
+		1: {"api/types.go", "sdk/client.go"},
+		2: {"docs/README.md"},
+	}
+
+	filePage := func(number int, page int) map[string]interface{} {
+		hasNextPage := number == 1 && page == 0
+
+		nodes := []interface{}{}
+		if number != 1 || page == 0 {
+			nodes = append(nodes, map[string]string{"path": files[number][0]})
+		} else {
+			nodes = append(nodes, map[string]string{"path": files[number][1]})
+		}
+
+		return map[string]interface{}{
+			"nodes": nodes,
+			"pageInfo": map[string]interface{}{
+				"endCursor":   "files-1",
+				"hasNextPage": hasNextPage,
+			},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if cursor, ok := req.Variables["cursor"].(string); ok {
+			number := int(req.Variables["number"].(float64))
+
+			if cursor != "files-1" {
+				http.Error(w, "unexpected cursor "+cursor, http.StatusBadRequest)
+				return
+			}
+
+			writeData(w, map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequest": map[string]interface{}{
+						"files": filePage(number, 1),
+					},
+				},
+			})
+
+			return
+		}
+
+		repository := map[string]interface{}{}
+		for i := 0; i < MaxPullRequestsPerQuery; i++ {
+			if has, _ := req.Variables[fmt.Sprintf("has%d", i)].(bool); !has {
+				continue
+			}
+
+			number := int(req.Variables[fmt.Sprintf("number%d", i)].(float64))
+			repository[fmt.Sprintf("pr%d", i)] = map[string]interface{}{
+				"number": number,
+				"files":  filePage(number, 0),
+			}
+		}
+
+		writeData(w, map[string]interface{}{"repository": repository})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+	client.changedFiles = true
+
+	result, err := client.PullRequestFiles(context.Background(), "kubermatic", "gchl", []int{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to fetch files: %v", err)
+	}
+
+	for number, expected := range files {
+		if !slices.Equal(expected, result[number]) {
+			t.Errorf("Expected files %v for PR #%d, got %v.", expected, number, result[number])
+		}
+	}
+}
@@ -23,17 +23,29 @@ import (
 	"k8c.io/gchl/pkg/types"
 
 	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type commitSchema struct {
 	OID                    string
 	MessageHeadline        string
+	Message                string
+	URL                    string
 	AssociatedPullRequests struct {
-		Nodes []graphqlPullRequest
+		Nodes    []graphqlPullRequest
+		PageInfo pageInfo
 	} `graphql:"associatedPullRequests(first: 5)"`
 }
 
+// commitObject is used to query commits by their hash.
+type commitObject struct {
+	Commit commitSchema `graphql:"... on Commit"`
+}
+
 type historyQuery struct {
+	rateLimited
+
 	Repository struct {
 		Object struct {
 			Commit struct {
@@ -49,36 +61,141 @@ type historyQuery struct {
 	} `graphql:"repository(name: $name, owner: $owner)"`
 }
 
-type Stopper func(types.Commit) bool
-
 // History will return all commits, beginning with the head hash, until the stop
 // function returns false.
-func (c *Client) History(ctx context.Context, owner string, name string, headHash string, stop Stopper) ([]types.Commit, error) {
+func (c *Client) History(ctx context.Context, owner string, name string, rng types.Range) ([]types.Commit, error) {
 	commits := []types.Commit{}
 	cursor := ""
+	recovered := 0
 
 	for {
 		var (
-			err  error
-			page []types.Commit
+			err      error
+			page     []types.Commit
+			recovery int
 		)
 
-		page, cursor, err = c.fetchHistoryPage(ctx, owner, name, headHash, stop, cursor)
+		page, cursor, recovery, err = c.fetchHistoryPage(ctx, owner, name, rng, cursor)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch commits: %w", err)
 		}
 
 		commits = append(commits, page...)
+		recovered += recovery
 
 		if cursor == "" {
 			break
 		}
 	}
 
+	if recovered > 0 {
+		c.log.WithField("commits", recovered).Info("Recovered pull requests from commit titles.")
+	}
+
+	if err := c.linkIssues(ctx, owner, name, commitPullRequests(commits)); err != nil {
+		return nil, err
+	}
+
 	return commits, nil
 }
 
-func (c *Client) fetchHistoryPage(ctx context.Context, owner string, name string, headHash string, stop Stopper, cursor string) ([]types.Commit, string, error) {
+// commitPullRequests returns pointers to the pull requests of the commits,
+// so that they can be updated in place.
+func commitPullRequests(commits []types.Commit) []*types.PullRequest {
+	prs := []*types.PullRequest{}
+	for i := range commits {
+		prs = append(prs, &commits[i].PullRequest)
+	}
+
+	return prs
+}
+
+// fetchHistoryPage returns the commits of a single page, the cursor for the
+// next page and the number of commits whose pull request was recovered from
+// the commit title.
+func (c *Client) fetchHistoryPage(ctx context.Context, owner string, name string, rng types.Range, cursor string) ([]types.Commit, string, int, error) {
+	c.log.WithField("cursor", cursor).Debug("fetchHistory()")
+
+	nodes, cursor, err := c.fetchHistoryNodes(ctx, owner, name, rng.Head, cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	titlePullRequests, err := c.fetchTitlePullRequests(ctx, owner, name, nodes)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	commits := []types.Commit{}
+	recovered := 0
+
+	for _, node := range nodes {
+		commit := c.convertCommit(node, rng.Branch)
+
+		if !commit.HasPullRequest() {
+			if pr, ok := titlePullRequests[types.PullRequestNumber(node.MessageHeadline)]; ok {
+				commit.Author = pr.Author
+				commit.PullRequest = pr
+				recovered++
+			}
+		}
+
+		stopped, err := rng.Stop(commit)
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		if stopped {
+			cursor = ""
+			break
+		}
+
+		if !commit.HasPullRequest() && !rng.IncludeDirectCommits {
+			c.log.WithField("commit", node.OID).Warn("Commit has no associated pull request.")
+			continue
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits, cursor, recovered, nil
+}
+
+// fetchTitlePullRequests fetches the pull requests mentioned in the titles of
+// commits that GitHub has no associated pull requests for. This happens for
+// example after a repository has been transferred or its history rewritten,
+// but squash-merged commits still end in "(#1234)".
+func (c *Client) fetchTitlePullRequests(ctx context.Context, owner string, name string, nodes []commitSchema) (map[int]types.PullRequest, error) {
+	numbers := sets.New[int]()
+	for _, node := range nodes {
+		if len(node.AssociatedPullRequests.Nodes) > 0 {
+			continue
+		}
+
+		if number := types.PullRequestNumber(node.MessageHeadline); number != 0 {
+			numbers.Insert(number)
+		}
+	}
+
+	if numbers.Len() == 0 {
+		return nil, nil
+	}
+
+	pullRequests, err := c.fetchBatchPullRequests(ctx, owner, name, sets.List(numbers))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pull requests from commit titles: %w", err)
+	}
+
+	return pullRequests, nil
+}
+
+// fetchHistoryNodes returns a single page of the commit history, beginning
+// with the head hash, and the cursor for the next page (if any).
+func (c *Client) fetchHistoryNodes(ctx context.Context, owner string, name string, headHash string, cursor string) ([]commitSchema, string, error) {
+	if c.cache != nil {
+		return c.fetchCachedHistoryNodes(ctx, owner, name, headHash, cursor)
+	}
+
 	variables := map[string]interface{}{
 		"owner": githubv4.String(owner),
 		"name":  githubv4.String(name),
@@ -91,11 +208,9 @@ func (c *Client) fetchHistoryPage(ctx context.Context, owner string, name string
 		variables["cursor"] = githubv4.String(cursor)
 	}
 
-	c.log.WithField("cursor", cursor).Debug("fetchHistory()")
-
 	var q historyQuery
 
-	err := c.client.Query(ctx, &q, variables)
+	err := c.query(ctx, &q, variables)
 	if err != nil {
 		return nil, "", err
 	}
@@ -105,36 +220,93 @@ func (c *Client) fetchHistoryPage(ctx context.Context, owner string, name string
 		cursor = string(info.EndCursor)
 	}
 
-	commits := []types.Commit{}
-	for _, node := range q.Repository.Object.Commit.History.Nodes {
-		if len(node.AssociatedPullRequests.Nodes) == 0 {
-			c.log.WithField("commit", node.OID).Warn("Commit has no associated pull request.")
-			continue
-		}
+	nodes := q.Repository.Object.Commit.History.Nodes
+	if err := c.completeCommits(ctx, owner, name, nodes); err != nil {
+		return nil, "", err
+	}
 
-		commit := convertCommit(node)
-		if stop(commit) {
-			cursor = ""
-			break
+	return nodes, cursor, nil
+}
+
+// convertCommit converts the commit, choosing the pull request that brought
+// it into the given branch (if known).
+func (c *Client) convertCommit(api commitSchema, branch string) types.Commit {
+	commit := types.Commit{
+		Hash:    api.OID,
+		Title:   api.MessageHeadline,
+		Message: api.Message,
+		URL:     api.URL,
+	}
+
+	candidates := api.AssociatedPullRequests.Nodes
+	if len(candidates) == 0 {
+		return commit
+	}
+
+	pr, tied := selectPullRequest(candidates, branch)
+
+	// the log is walked without a branch and does not care about pull requests
+	if len(candidates) > 1 && branch != "" {
+		numbers := []int{}
+		for _, candidate := range candidates {
+			numbers = append(numbers, candidate.Number)
 		}
 
-		commits = append(commits, commit)
+		entry := c.log.WithFields(logrus.Fields{
+			"commit":     api.OID,
+			"branch":     branch,
+			"candidates": numbers,
+			"pr":         pr.Number,
+		})
+
+		if tied {
+			entry.Warn("Commit has multiple equally suitable pull requests, using the first one.")
+		} else {
+			entry.Debug("Commit has multiple associated pull requests.")
+		}
 	}
 
-	return commits, cursor, nil
+	commit.Author = pr.Author.Login
+	commit.PullRequest = convertPullRequest(pr)
+
+	return commit
 }
 
-func convertCommit(api commitSchema) types.Commit {
-	pr := api.AssociatedPullRequests.Nodes[0]
+// selectPullRequest picks the pull request that brought a commit into the
+// branch. A commit can be associated with several pull requests, e.g. the
+// original one, its cherry-picks and forward-ports. Merged pull requests are
+// preferred over unmerged ones and within those, pull requests targeting the
+// branch are preferred. The second return value is true if the choice was
+// ambiguous, i.e. there were other, equally suitable pull requests.
+func selectPullRequest(candidates []graphqlPullRequest, branch string) (graphqlPullRequest, bool) {
+	score := func(pr graphqlPullRequest) int {
+		result := 0
+
+		if pr.Merged {
+			result += 2
+		}
 
-	commit := types.Commit{
-		Hash:        api.OID,
-		Title:       api.MessageHeadline,
-		Author:      pr.Author.Login,
-		PullRequest: convertPullRequest(pr),
+		if branch != "" && pr.BaseRefName == branch {
+			result++
+		}
+
+		return result
 	}
 
-	return commit
+	best := 0
+	tied := false
+
+	for i := 1; i < len(candidates); i++ {
+		switch s := score(candidates[i]); {
+		case s > score(candidates[best]):
+			best = i
+			tied = false
+		case s == score(candidates[best]):
+			tied = true
+		}
+	}
+
+	return candidates[best], tied
 }
 
 func (c *Client) Log(ctx context.Context, owner string, name string, headHash string, maxCommits int) ([]types.Commit, error) {
@@ -168,6 +340,50 @@ func (c *Client) Log(ctx context.Context, owner string, name string, headHash st
 }
 
 func (c *Client) fetchLogPage(ctx context.Context, owner string, name string, headHash string, cursor string) ([]types.Commit, string, error) {
+	c.log.WithField("cursor", cursor).Debug("fetchLog()")
+
+	nodes, cursor, err := c.fetchHistoryNodes(ctx, owner, name, headHash, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// in contrast to the history, the log contains commits without a pull
+	// request as well, as they still mark the point where branches meet
+	commits := []types.Commit{}
+	for _, commit := range nodes {
+		commits = append(commits, c.convertCommit(commit, ""))
+	}
+
+	return commits, cursor, nil
+}
+
+type historyHashesQuery struct {
+	rateLimited
+
+	Repository struct {
+		Object struct {
+			Commit struct {
+				History struct {
+					Nodes []struct {
+						OID             string
+						MessageHeadline string
+						Message         string
+						URL             string
+					}
+					PageInfo struct {
+						EndCursor   githubv4.String
+						HasNextPage bool
+					}
+				} `graphql:"history(first: 100, after: $cursor)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(expression: $head)"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// fetchCachedHistoryNodes is like fetchHistoryNodes, but only fetches the commit
+// hashes from the history and then takes the associated pull requests from the
+// cache. Only commits that are not cached yet are fetched in full.
+func (c *Client) fetchCachedHistoryNodes(ctx context.Context, owner string, name string, headHash string, cursor string) ([]commitSchema, string, error) {
 	variables := map[string]interface{}{
 		"owner": githubv4.String(owner),
 		"name":  githubv4.String(name),
@@ -180,11 +396,9 @@ func (c *Client) fetchLogPage(ctx context.Context, owner string, name string, he
 		variables["cursor"] = githubv4.String(cursor)
 	}
 
-	c.log.WithField("cursor", cursor).Debug("fetchLog()")
-
-	var q historyQuery
+	var q historyHashesQuery
 
-	err := c.client.Query(ctx, &q, variables)
+	err := c.query(ctx, &q, variables)
 	if err != nil {
 		return nil, "", err
 	}
@@ -194,15 +408,108 @@ func (c *Client) fetchLogPage(ctx context.Context, owner string, name string, he
 		cursor = string(info.EndCursor)
 	}
 
-	commits := []types.Commit{}
-	for _, commit := range q.Repository.Object.Commit.History.Nodes {
-		if len(commit.AssociatedPullRequests.Nodes) == 0 {
-			c.log.WithField("commit", commit.OID).Warn("Commit has no associated pull request.")
+	history := q.Repository.Object.Commit.History.Nodes
+
+	associations := map[string][]int{}
+	uncached := []string{}
+	numbers := sets.New[int]()
+
+	for _, node := range history {
+		prs, ok := c.cache.commit(owner, name, node.OID)
+		if !ok {
+			uncached = append(uncached, node.OID)
 			continue
 		}
 
-		commits = append(commits, convertCommit(commit))
+		associations[node.OID] = prs
+		numbers.Insert(prs...)
 	}
 
-	return commits, cursor, nil
+	c.log.WithFields(logrus.Fields{
+		"cached":   len(history) - len(uncached),
+		"uncached": len(uncached),
+	}).Debug("Resolved commits from cache.")
+
+	fetched, err := c.fetchCommitsByHash(ctx, owner, name, uncached)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pullRequests, err := c.fetchCachedPullRequests(ctx, owner, name, sets.List(numbers))
+	if err != nil {
+		return nil, "", err
+	}
+
+	nodes := []commitSchema{}
+	for _, node := range history {
+		if commit, ok := fetched[node.OID]; ok {
+			nodes = append(nodes, commit)
+			continue
+		}
+
+		commit := commitSchema{
+			OID:             node.OID,
+			MessageHeadline: node.MessageHeadline,
+			Message:         node.Message,
+			URL:             node.URL,
+		}
+
+		for _, number := range associations[node.OID] {
+			if pr, ok := pullRequests[number]; ok {
+				commit.AssociatedPullRequests.Nodes = append(commit.AssociatedPullRequests.Nodes, pr)
+			}
+		}
+
+		nodes = append(nodes, commit)
+	}
+
+	return nodes, cursor, nil
+}
+
+// fetchCommitsByHash fetches the given commits including their associated pull
+// requests and stores them in the cache.
+func (c *Client) fetchCommitsByHash(ctx context.Context, owner string, name string, hashes []string) (map[string]commitSchema, error) {
+	result := map[string]commitSchema{}
+
+	for len(hashes) > 0 {
+		size := min(len(hashes), MaxCommitsPerQuery)
+		chunk := hashes[:size]
+
+		variables := getHashedQueryVariables(chunk, MaxCommitsPerQuery)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(name)
+
+		c.log.WithField("commits", len(chunk)).Debug("fetchCommits()")
+
+		var q hashedCommitQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return nil, err
+		}
+
+		commits := q.GetAll()
+		if err := c.completeCommits(ctx, owner, name, commits); err != nil {
+			return nil, err
+		}
+
+		for _, commit := range commits {
+			if c.cache != nil {
+				if err := c.cache.storeCommit(owner, name, commit); err != nil {
+					return nil, fmt.Errorf("failed to cache commit: %w", err)
+				}
+
+				for _, pr := range commit.AssociatedPullRequests.Nodes {
+					if err := c.cache.storePullRequest(owner, name, pr); err != nil {
+						return nil, fmt.Errorf("failed to cache pull request: %w", err)
+					}
+				}
+			}
+
+			result[commit.OID] = commit
+		}
+
+		hashes = hashes[size:]
+	}
+
+	return result, nil
 }
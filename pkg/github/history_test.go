@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestSelectPullRequest(t *testing.T) {
+	pr := func(number int, base string, merged bool) graphqlPullRequest {
+		return graphqlPullRequest{
+			Number:      number,
+			BaseRefName: base,
+			Merged:      merged,
+		}
+	}
+
+	testcases := []struct {
+		name       string
+		candidates []graphqlPullRequest
+		branch     string
+		expected   int
+		tied       bool
+	}{
+		{
+			name:       "single pull request",
+			candidates: []graphqlPullRequest{pr(1, "main", true)},
+			branch:     "release/v1.2",
+			expected:   1,
+		},
+		{
+			name:       "cherry-pick on the release branch wins over the original",
+			candidates: []graphqlPullRequest{pr(1, "main", true), pr(2, "release/v1.2", true), pr(3, "release/v1.1", true)},
+			branch:     "release/v1.2",
+			expected:   2,
+		},
+		{
+			name:       "merged pull requests win over open ones targeting the branch",
+			candidates: []graphqlPullRequest{pr(1, "release/v1.2", false), pr(2, "main", true)},
+			branch:     "release/v1.2",
+			expected:   2,
+		},
+		{
+			name:       "ambiguous pull requests",
+			candidates: []graphqlPullRequest{pr(1, "main", true), pr(2, "main", true)},
+			branch:     "main",
+			expected:   1,
+			tied:       true,
+		},
+		{
+			name:       "unknown branch prefers merged pull requests",
+			candidates: []graphqlPullRequest{pr(1, "main", false), pr(2, "main", true)},
+			expected:   2,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			selected, tied := selectPullRequest(testcase.candidates, testcase.branch)
+
+			if selected.Number != testcase.expected {
+				t.Errorf("Expected PR #%d, got #%d.", testcase.expected, selected.Number)
+			}
+
+			if tied != testcase.tied {
+				t.Errorf("Expected tied=%v, got %v.", testcase.tied, tied)
+			}
+		})
+	}
+}
+
+// fakeHistoryServer serves a history of three commits, of which only the
+// newest has an associated pull request. All other queries are answered
+// by the embedded pull request server.
+type fakeHistoryServer struct {
+	*fakePullRequestServer
+}
+
+func (f *fakeHistoryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := readRequestBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !strings.Contains(string(body), "history(first: 50") {
+		f.fakePullRequestServer.ServeHTTP(w, r)
+		return
+	}
+
+	commit := func(oid string, title string, prs ...int) map[string]interface{} {
+		nodes := []interface{}{}
+		for _, number := range prs {
+			nodes = append(nodes, map[string]interface{}{
+				"number": number,
+				"merged": true,
+				"author": map[string]string{"login": "user"},
+				"labels": map[string]interface{}{"nodes": []interface{}{}},
+			})
+		}
+
+		return map[string]interface{}{
+			"oid":             oid,
+			"messageHeadline": title,
+			"associatedPullRequests": map[string]interface{}{
+				"nodes": nodes,
+			},
+		}
+	}
+
+	writeData(w, map[string]interface{}{
+		"repository": map[string]interface{}{
+			"object": map[string]interface{}{
+				"history": map[string]interface{}{
+					"nodes": []interface{}{
+						commit("c3", "Add feature (#3)", 3),
+						commit("c2", "Fix bug (#2)"),
+						commit("c1", "Initial commit"),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestHistoryRecoversPullRequestsFromTitles(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	server := httptest.NewServer(&fakeHistoryServer{
+		fakePullRequestServer: &fakePullRequestServer{
+			updatedAt: map[int]time.Time{2: now, 3: now},
+		},
+	})
+	t.Cleanup(server.Close)
+
+	commits, err := newCachingClient(t, server.URL, "").History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head: "c3",
+		Stop: func(types.Commit) (bool, error) {
+			return false, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	numbers := map[string]int{}
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+		numbers[commit.Hash] = commit.PullRequest.Number
+	}
+
+	if expected := []string{"c3", "c2"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+
+	if numbers["c2"] != 2 {
+		t.Errorf("Expected PR #2 to be recovered from the commit title, got #%d.", numbers["c2"])
+	}
+
+	if recovered := commits[1]; recovered.Author != "user" {
+		t.Errorf("Expected the author to be taken from the recovered PR, got %q.", recovered.Author)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/shurcooL/githubv4"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// pullRequestIssues are queried separately from the other pull request
+// fields, as older GitHub Enterprise Server releases do not support them.
+type pullRequestIssues struct {
+	Number                  int
+	ClosingIssuesReferences struct {
+		Nodes    []graphqlIssue
+		PageInfo pageInfo
+	} `graphql:"closingIssuesReferences(first: 25)"`
+}
+
+type graphqlIssue struct {
+	Number int
+	Title  string
+	URL    string
+}
+
+// linkIssues attaches the issues that each pull request closes. If the API does
+// not support closing issue references, the pull requests are left unchanged.
+func (c *Client) linkIssues(ctx context.Context, owner string, name string, prs []*types.PullRequest) error {
+	if !c.closingIssues {
+		return nil
+	}
+
+	byNumber := map[int][]*types.PullRequest{}
+	for _, pr := range prs {
+		if pr.Number != 0 {
+			byNumber[pr.Number] = append(byNumber[pr.Number], pr)
+		}
+	}
+
+	uncached := []int{}
+	for _, number := range sets.List(sets.KeySet(byNumber)) {
+		// cached issues are only up-to-date if the pull request is
+		if c.cache != nil && c.cache.isValidated(owner, name, number) {
+			if issues, ok := c.cache.pullRequestIssues(owner, name, number); ok {
+				for _, pr := range byNumber[number] {
+					pr.Issues = issues
+				}
+
+				continue
+			}
+		}
+
+		uncached = append(uncached, number)
+	}
+
+	// every chunk updates different pull requests, so no locking is needed
+	return parallel.ForEachChunk(ctx, c.concurrency, uncached, MaxPullRequestsPerQuery, func(ctx context.Context, chunk []int) error {
+		variables := getNumberedQueryVariables(chunk, MaxPullRequestsPerQuery)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(name)
+
+		c.log.WithField("prs", len(chunk)).Debug("fetchPullRequestIssues()")
+
+		var q numberedPullRequestIssuesQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return fmt.Errorf("failed to fetch closed issues: %w", err)
+		}
+
+		all := q.GetAll()
+		if err := c.completeIssues(ctx, owner, name, all); err != nil {
+			return err
+		}
+
+		for _, api := range all {
+			var issues []types.Issue
+			for _, issue := range api.ClosingIssuesReferences.Nodes {
+				issues = append(issues, types.Issue{
+					Number: issue.Number,
+					Title:  issue.Title,
+					URL:    issue.URL,
+				})
+			}
+
+			for _, pr := range byNumber[api.Number] {
+				pr.Issues = issues
+			}
+
+			if c.cache != nil && c.cache.isValidated(owner, name, api.Number) {
+				if err := c.cache.storePullRequestIssues(owner, name, api.Number, issues); err != nil {
+					return fmt.Errorf("failed to cache closed issues: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
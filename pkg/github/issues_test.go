@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestLinkIssues(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		requests++
+
+		// only PR #42 closes an issue
+		repository := map[string]interface{}{}
+		for i := 0; i < MaxPullRequestsPerQuery; i++ {
+			if has, _ := req.Variables[fmt.Sprintf("has%d", i)].(bool); !has {
+				continue
+			}
+
+			number := int(req.Variables[fmt.Sprintf("number%d", i)].(float64))
+
+			nodes := []interface{}{}
+			if number == 42 {
+				nodes = append(nodes, map[string]interface{}{"number": 7, "title": "Outdated dependencies", "url": "https://github.com/kubermatic/gchl/issues/7"})
+			}
+
+			repository[fmt.Sprintf("pr%d", i)] = map[string]interface{}{
+				"number":                  number,
+				"closingIssuesReferences": map[string]interface{}{"nodes": nodes},
+			}
+		}
+
+		writeData(w, map[string]interface{}{"repository": repository})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+	client.closingIssues = true
+
+	commits := []types.Commit{
+		{Hash: "c3", PullRequest: types.PullRequest{Number: 42}},
+		{Hash: "c2"},
+		{Hash: "c1", PullRequest: types.PullRequest{Number: 1}},
+	}
+
+	if err := client.linkIssues(context.Background(), "kubermatic", "gchl", commitPullRequests(commits)); err != nil {
+		t.Fatalf("Failed to link issues: %v", err)
+	}
+
+	expected := []types.Issue{{Number: 7, Title: "Outdated dependencies", URL: "https://github.com/kubermatic/gchl/issues/7"}}
+	if !slices.Equal(expected, commits[0].PullRequest.Issues) {
+		t.Errorf("Expected issues %v, got %v.", expected, commits[0].PullRequest.Issues)
+	}
+
+	if len(commits[2].PullRequest.Issues) > 0 {
+		t.Errorf("Expected no issues for PR #1, got %v.", commits[2].PullRequest.Issues)
+	}
+
+	// without support for closing issue references, no requests are made
+	client.closingIssues = false
+
+	if err := client.linkIssues(context.Background(), "kubermatic", "gchl", commitPullRequests(commits)); err != nil {
+		t.Fatalf("Failed to link issues: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d.", requests)
+	}
+}
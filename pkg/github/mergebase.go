@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"k8c.io/gchl/pkg/source"
+)
+
+var _ source.MergeBaser = &Client{}
+
+// MergeBase uses the REST compare API, as GraphQL offers no way to
+// determine the merge base of two commits.
+func (c *Client) MergeBase(ctx context.Context, owner string, name string, a string, b string) (string, error) {
+	endpoint := fmt.Sprintf("%s/repos/%s/%s/compare/%s...%s", c.restURL, url.PathEscape(owner), url.PathEscape(name), url.PathEscape(a), url.PathEscape(b))
+
+	c.log.WithField("url", endpoint).Debug("compare()")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to compare %s and %s: %w", a, b, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to compare %s and %s: non-200 OK status code: %v body: %q", a, b, resp.Status, body)
+	}
+
+	var comparison struct {
+		MergeBaseCommit struct {
+			SHA string `json:"sha"`
+		} `json:"merge_base_commit"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&comparison); err != nil {
+		return "", fmt.Errorf("failed to decode comparison: %w", err)
+	}
+
+	if comparison.MergeBaseCommit.SHA == "" {
+		return "", errors.New("comparison contains no merge base")
+	}
+
+	return comparison.MergeBaseCommit.SHA, nil
+}
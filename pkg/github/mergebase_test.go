@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestMergeBase(t *testing.T) {
+	ctx := context.Background()
+	fixtures := t.TempDir()
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/graphql", &fakePullRequestServer{})
+	mux.HandleFunc("/api/v3/repos/kubermatic/gchl/compare/v1.0.0...release%2Fv1.1", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"merge_base_commit": map[string]string{"sha": "c42"},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+
+	recorder, err := NewClient(ctx, logrus.New(), ClientOptions{
+		URL:       server.URL,
+		Token:     "test",
+		RecordDir: fixtures,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	base, err := recorder.MergeBase(ctx, "kubermatic", "gchl", "v1.0.0", "release/v1.1")
+	if err != nil {
+		t.Fatalf("Failed to determine merge base: %v", err)
+	}
+
+	if base != "c42" {
+		t.Errorf("Expected merge base c42, got %q.", base)
+	}
+
+	// REST requests have no body, but must be replayable nonetheless
+	server.Close()
+
+	replayer, err := NewClient(ctx, logrus.New(), ClientOptions{
+		URL:       server.URL,
+		ReplayDir: fixtures,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if base, err := replayer.MergeBase(ctx, "kubermatic", "gchl", "v1.0.0", "release/v1.1"); err != nil || base != "c42" {
+		t.Errorf("Expected replayed merge base c42, got %q (error: %v).", base, err)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+)
+
+type pageInfo struct {
+	EndCursor   githubv4.String
+	HasNextPage bool
+}
+
+type pullRequestLabelsQuery struct {
+	rateLimited
+
+	Repository struct {
+		PullRequest struct {
+			Labels struct {
+				Nodes    []graphqlLabel
+				PageInfo pageInfo
+			} `graphql:"labels(first: 100, after: $cursor)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type pullRequestFilesQuery struct {
+	rateLimited
+
+	Repository struct {
+		PullRequest struct {
+			Files struct {
+				Nodes    []graphqlFile
+				PageInfo pageInfo
+			} `graphql:"files(first: 100, after: $cursor)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type pullRequestIssuesQuery struct {
+	rateLimited
+
+	Repository struct {
+		PullRequest struct {
+			ClosingIssuesReferences struct {
+				Nodes    []graphqlIssue
+				PageInfo pageInfo
+			} `graphql:"closingIssuesReferences(first: 100, after: $cursor)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type commitPullRequestsQuery struct {
+	rateLimited
+
+	Repository struct {
+		Object struct {
+			Commit struct {
+				AssociatedPullRequests struct {
+					Nodes    []graphqlPullRequest
+					PageInfo pageInfo
+				} `graphql:"associatedPullRequests(first: 25, after: $cursor)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(oid: $oid)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// completeCommits fetches the remaining associated pull requests (and their
+// labels) for all commits whose connections did not fit into the initial query.
+func (c *Client) completeCommits(ctx context.Context, owner string, name string, commits []commitSchema) error {
+	for i := range commits {
+		if err := c.completeCommit(ctx, owner, name, &commits[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) completeCommit(ctx context.Context, owner string, name string, commit *commitSchema) error {
+	prs := &commit.AssociatedPullRequests
+
+	for prs.PageInfo.HasNextPage {
+		c.log.WithField("commit", commit.OID).Debug("fetchAssociatedPullRequests()")
+
+		variables := map[string]interface{}{
+			"owner":  githubv4.String(owner),
+			"name":   githubv4.String(name),
+			"oid":    githubv4.GitObjectID(commit.OID),
+			"cursor": prs.PageInfo.EndCursor,
+		}
+
+		var q commitPullRequestsQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return fmt.Errorf("failed to fetch pull requests associated with commit %s: %w", commit.OID, err)
+		}
+
+		page := q.Repository.Object.Commit.AssociatedPullRequests
+
+		prs.Nodes = append(prs.Nodes, page.Nodes...)
+		prs.PageInfo = page.PageInfo
+	}
+
+	return c.completeLabels(ctx, owner, name, prs.Nodes)
+}
+
+// completeLabels fetches the remaining labels for all pull requests that have
+// more labels than fit into the initial query.
+func (c *Client) completeLabels(ctx context.Context, owner string, name string, prs []graphqlPullRequest) error {
+	for i := range prs {
+		labels := &prs[i].Labels
+
+		for labels.PageInfo.HasNextPage {
+			c.log.WithField("pr", prs[i].Number).Debug("fetchLabels()")
+
+			variables := map[string]interface{}{
+				"owner":  githubv4.String(owner),
+				"name":   githubv4.String(name),
+				"number": githubv4.Int(prs[i].Number),
+				"cursor": labels.PageInfo.EndCursor,
+			}
+
+			var q pullRequestLabelsQuery
+
+			if err := c.query(ctx, &q, variables); err != nil {
+				return fmt.Errorf("failed to fetch labels of PR #%d: %w", prs[i].Number, err)
+			}
+
+			page := q.Repository.PullRequest.Labels
+
+			labels.Nodes = append(labels.Nodes, page.Nodes...)
+			labels.PageInfo = page.PageInfo
+		}
+	}
+
+	return nil
+}
+
+// completeFiles fetches the remaining changed files for all pull requests that
+// changed more files than fit into the initial query.
+func (c *Client) completeFiles(ctx context.Context, owner string, name string, prs []pullRequestFiles) error {
+	for i := range prs {
+		files := &prs[i].Files
+
+		for files.PageInfo.HasNextPage {
+			c.log.WithField("pr", prs[i].Number).Debug("fetchFiles()")
+
+			variables := map[string]interface{}{
+				"owner":  githubv4.String(owner),
+				"name":   githubv4.String(name),
+				"number": githubv4.Int(prs[i].Number),
+				"cursor": files.PageInfo.EndCursor,
+			}
+
+			var q pullRequestFilesQuery
+
+			if err := c.query(ctx, &q, variables); err != nil {
+				return fmt.Errorf("failed to fetch files of PR #%d: %w", prs[i].Number, err)
+			}
+
+			page := q.Repository.PullRequest.Files
+
+			files.Nodes = append(files.Nodes, page.Nodes...)
+			files.PageInfo = page.PageInfo
+		}
+	}
+
+	return nil
+}
+
+// completeIssues fetches the remaining closed issues for all pull requests that
+// close more issues than fit into the initial query.
+func (c *Client) completeIssues(ctx context.Context, owner string, name string, prs []pullRequestIssues) error {
+	for i := range prs {
+		issues := &prs[i].ClosingIssuesReferences
+
+		for issues.PageInfo.HasNextPage {
+			c.log.WithField("pr", prs[i].Number).Debug("fetchIssues()")
+
+			variables := map[string]interface{}{
+				"owner":  githubv4.String(owner),
+				"name":   githubv4.String(name),
+				"number": githubv4.Int(prs[i].Number),
+				"cursor": issues.PageInfo.EndCursor,
+			}
+
+			var q pullRequestIssuesQuery
+
+			if err := c.query(ctx, &q, variables); err != nil {
+				return fmt.Errorf("failed to fetch closed issues of PR #%d: %w", prs[i].Number, err)
+			}
+
+			page := q.Repository.PullRequest.ClosingIssuesReferences
+
+			issues.Nodes = append(issues.Nodes, page.Nodes...)
+			issues.PageInfo = page.PageInfo
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newPaginationServer serves the follow-up queries for truncated connections:
+// every label and closed issue page contains a single label or issue and the
+// associated pull requests are returned in two pages, one pull request each.
+func newPaginationServer(t *testing.T) *httptest.Server {
+	labelPage := func(cursor string) map[string]interface{} {
+		page := 0
+		fmt.Sscanf(cursor, "labels-%d", &page)
+
+		return map[string]interface{}{
+			"nodes": []interface{}{map[string]string{"name": fmt.Sprintf("label-%d", page+1)}},
+			"pageInfo": map[string]interface{}{
+				"endCursor":   fmt.Sprintf("labels-%d", page+1),
+				"hasNextPage": page+1 < 3,
+			},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		cursor, _ := req.Variables["cursor"].(string)
+
+		switch {
+		case strings.Contains(req.Query, "associatedPullRequests(first: 25"):
+			number := 2
+			if cursor == "prs-2" {
+				number = 3
+			}
+
+			writeData(w, map[string]interface{}{
+				"repository": map[string]interface{}{
+					"object": map[string]interface{}{
+						"associatedPullRequests": map[string]interface{}{
+							"nodes": []interface{}{map[string]interface{}{
+								"number": number,
+								"labels": labelPage("labels-0"),
+							}},
+							"pageInfo": map[string]interface{}{
+								"endCursor":   fmt.Sprintf("prs-%d", number),
+								"hasNextPage": number < 3,
+							},
+						},
+					},
+				},
+			})
+
+		case strings.Contains(req.Query, "closingIssuesReferences(first: 100"):
+			page := 0
+			fmt.Sscanf(cursor, "issues-%d", &page)
+
+			writeData(w, map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequest": map[string]interface{}{
+						"closingIssuesReferences": map[string]interface{}{
+							"nodes": []interface{}{map[string]interface{}{"number": page + 1}},
+							"pageInfo": map[string]interface{}{
+								"endCursor":   fmt.Sprintf("issues-%d", page+1),
+								"hasNextPage": page+1 < 3,
+							},
+						},
+					},
+				},
+			})
+
+		case strings.Contains(req.Query, "labels(first: 100"):
+			writeData(w, map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequest": map[string]interface{}{
+						"labels": labelPage(cursor),
+					},
+				},
+			})
+
+		default:
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCompleteCommit(t *testing.T) {
+	server := newPaginationServer(t)
+
+	client := newTestClient(server)
+
+	commit := commitSchema{OID: "0123456789012345678901234567890123456789"}
+	commit.AssociatedPullRequests.PageInfo = pageInfo{EndCursor: "prs-1", HasNextPage: true}
+
+	first := graphqlPullRequest{Number: 1}
+	first.Labels.Nodes = []graphqlLabel{{Name: "label-1"}}
+	first.Labels.PageInfo = pageInfo{EndCursor: "labels-1", HasNextPage: true}
+
+	commit.AssociatedPullRequests.Nodes = []graphqlPullRequest{first}
+
+	if err := client.completeCommit(context.Background(), "kubermatic", "gchl", &commit); err != nil {
+		t.Fatalf("Failed to complete commit: %v", err)
+	}
+
+	numbers := []int{}
+	for _, pr := range commit.AssociatedPullRequests.Nodes {
+		numbers = append(numbers, pr.Number)
+
+		labels := []string{}
+		for _, label := range pr.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
+
+		if expected := []string{"label-1", "label-2", "label-3"}; !slices.Equal(expected, labels) {
+			t.Errorf("PR #%d: expected labels %v, got %v.", pr.Number, expected, labels)
+		}
+	}
+
+	if expected := []int{1, 2, 3}; !slices.Equal(expected, numbers) {
+		t.Errorf("Expected pull requests %v, got %v.", expected, numbers)
+	}
+}
+
+func TestCompleteIssues(t *testing.T) {
+	server := newPaginationServer(t)
+
+	client := newTestClient(server)
+
+	pr := pullRequestIssues{Number: 1}
+	pr.ClosingIssuesReferences.Nodes = []graphqlIssue{{Number: 1}}
+	pr.ClosingIssuesReferences.PageInfo = pageInfo{EndCursor: "issues-1", HasNextPage: true}
+
+	prs := []pullRequestIssues{pr}
+
+	if err := client.completeIssues(context.Background(), "kubermatic", "gchl", prs); err != nil {
+		t.Fatalf("Failed to complete issues: %v", err)
+	}
+
+	numbers := []int{}
+	for _, issue := range prs[0].ClosingIssuesReferences.Nodes {
+		numbers = append(numbers, issue.Number)
+	}
+
+	if expected := []int{1, 2, 3}; !slices.Equal(expected, numbers) {
+		t.Errorf("Expected issues %v, got %v.", expected, numbers)
+	}
+}
@@ -18,59 +18,132 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
+	"k8c.io/gchl/pkg/parallel"
 	"k8c.io/gchl/pkg/types"
 
 	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type graphqlPullRequest struct {
-	Number int
-	Title  string
-	Body   string
-	Author struct {
+	Number      int
+	Title       string
+	Body        string
+	URL         string
+	BaseRefName string
+	HeadRefName string
+	Merged      bool
+	MergedAt    *githubv4.DateTime
+	UpdatedAt   githubv4.DateTime
+	Author      struct {
+		Login    string
+		Typename string `graphql:"__typename"`
+	}
+	MergedBy *struct {
 		Login string
 	}
+	Milestone *struct {
+		Title string
+	}
 
 	Labels struct {
-		Nodes []struct {
-			Name string
-		}
+		Nodes    []graphqlLabel
+		PageInfo pageInfo
 	} `graphql:"labels(first: 50)"`
 }
 
+type graphqlLabel struct {
+	Name string
+}
+
+// pullRequestStamp is used to cheaply check if a cached pull request is
+// still up-to-date.
+type pullRequestStamp struct {
+	Number    int
+	UpdatedAt githubv4.DateTime
+}
+
 func (c *Client) FetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+	pullRequests, err := c.fetchBatchPullRequests(ctx, owner, name, numbers)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := []*types.PullRequest{}
+	for number := range pullRequests {
+		pr := pullRequests[number]
+		prs = append(prs, &pr)
+	}
+
+	if err := c.linkIssues(ctx, owner, name, prs); err != nil {
+		return nil, err
+	}
+
 	result := map[int]types.PullRequest{}
+	for _, pr := range prs {
+		result[pr.Number] = *pr
+	}
 
-	for {
-		size := MaxPullRequestsPerQuery
-		if len(numbers) < size {
-			size = len(numbers)
-		}
-		chunk := numbers[:size]
+	return result, nil
+}
+
+// fetchBatchPullRequests fetches the pull requests without linking their issues.
+func (c *Client) fetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+	var (
+		pullRequests map[int]graphqlPullRequest
+		err          error
+	)
+
+	if c.cache != nil {
+		pullRequests, err = c.fetchCachedPullRequests(ctx, owner, name, numbers)
+	} else {
+		pullRequests, err = c.fetchPullRequests(ctx, owner, name, numbers)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[int]types.PullRequest{}
+	for number, pr := range pullRequests {
+		result[number] = convertPullRequest(pr)
+	}
 
-		// do stuff
-		chunkResult, err := c.fetchPullRequests(ctx, owner, name, chunk)
+	return result, nil
+}
+
+func (c *Client) fetchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]graphqlPullRequest, error) {
+	var lock sync.Mutex
+
+	result := map[int]graphqlPullRequest{}
+
+	err := parallel.ForEachChunk(ctx, c.concurrency, numbers, MaxPullRequestsPerQuery, func(ctx context.Context, chunk []int) error {
+		chunkResult, err := c.fetchPullRequestsChunk(ctx, owner, name, chunk)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
+		lock.Lock()
+		defer lock.Unlock()
+
 		for k, v := range chunkResult {
 			result[k] = v
 		}
 
-		// shrink list
-		numbers = numbers[size:]
-		if len(numbers) == 0 {
-			break
-		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
-func (c *Client) fetchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+func (c *Client) fetchPullRequestsChunk(ctx context.Context, owner string, name string, numbers []int) (map[int]graphqlPullRequest, error) {
 	variables := getNumberedQueryVariables(numbers, MaxPullRequestsPerQuery)
 	variables["owner"] = githubv4.String(owner)
 	variables["name"] = githubv4.String(name)
@@ -79,29 +152,152 @@ func (c *Client) fetchPullRequests(ctx context.Context, owner string, name strin
 
 	var q numberedPullRequestQuery
 
-	err := c.client.Query(ctx, &q, variables)
+	err := c.query(ctx, &q, variables)
 	if err != nil {
 		return nil, err
 	}
 
-	prs := map[int]types.PullRequest{}
-	for _, pr := range q.GetAll() {
-		prs[pr.Number] = convertPullRequest(pr)
+	all := q.GetAll()
+	if err := c.completeLabels(ctx, owner, name, all); err != nil {
+		return nil, err
+	}
+
+	prs := map[int]graphqlPullRequest{}
+	for _, pr := range all {
+		prs[pr.Number] = pr
+
+		if c.cache != nil {
+			if err := c.cache.storePullRequest(owner, name, pr); err != nil {
+				return nil, fmt.Errorf("failed to cache pull request: %w", err)
+			}
+		}
 	}
 
 	return prs, nil
 }
 
+// fetchCachedPullRequests returns the pull requests from the cache, if they are
+// still up-to-date. Outdated and uncached pull requests are fetched in full.
+func (c *Client) fetchCachedPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]graphqlPullRequest, error) {
+	result := map[int]graphqlPullRequest{}
+	cached := map[int]graphqlPullRequest{}
+	uncached := []int{}
+	unvalidated := []int{}
+
+	for _, number := range numbers {
+		pr, ok := c.cache.pullRequest(owner, name, number)
+
+		switch {
+		case !ok:
+			uncached = append(uncached, number)
+		case c.cache.isValidated(owner, name, number):
+			result[number] = pr
+		default:
+			cached[number] = pr
+			unvalidated = append(unvalidated, number)
+		}
+	}
+
+	stamps, err := c.fetchPullRequestStamps(ctx, owner, name, unvalidated)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, number := range unvalidated {
+		stamp, ok := stamps[number]
+		if ok && stamp.Equal(cached[number].UpdatedAt.Time) {
+			c.cache.markValidated(owner, name, number)
+			result[number] = cached[number]
+		} else {
+			uncached = append(uncached, number)
+		}
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"cached":   len(result),
+		"uncached": len(uncached),
+	}).Debug("Resolved pull requests from cache.")
+
+	fetched, err := c.fetchPullRequests(ctx, owner, name, uncached)
+	if err != nil {
+		return nil, err
+	}
+
+	for number, pr := range fetched {
+		result[number] = pr
+	}
+
+	return result, nil
+}
+
+// fetchPullRequestStamps returns the last update timestamps of the given pull requests.
+func (c *Client) fetchPullRequestStamps(ctx context.Context, owner string, name string, numbers []int) (map[int]time.Time, error) {
+	var lock sync.Mutex
+
+	result := map[int]time.Time{}
+
+	err := parallel.ForEachChunk(ctx, c.concurrency, numbers, MaxPullRequestsPerQuery, func(ctx context.Context, chunk []int) error {
+		variables := getNumberedQueryVariables(chunk, MaxPullRequestsPerQuery)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(name)
+
+		c.log.WithField("prs", len(chunk)).Debug("fetchPullRequestStamps()")
+
+		var q numberedPullRequestStampQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, stamp := range q.GetAll() {
+			result[stamp.Number] = stamp.UpdatedAt.Time
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func convertPullRequest(api graphqlPullRequest) types.PullRequest {
 	labels := sets.New[string]()
 	for _, label := range api.Labels.Nodes {
 		labels.Insert(label.Name)
 	}
 
-	return types.PullRequest{
-		Number: api.Number,
-		Title:  api.Title,
-		Body:   api.Body,
-		Labels: sets.List(labels),
+	pr := types.PullRequest{
+		Number:     api.Number,
+		Title:      api.Title,
+		Body:       api.Body,
+		Author:     api.Author.Login,
+		AuthorType: types.AuthorTypeUser,
+		URL:        api.URL,
+		Labels:     sets.List(labels),
+		BaseBranch: api.BaseRefName,
+		HeadBranch: api.HeadRefName,
+	}
+
+	if api.Author.Typename == "Bot" {
+		pr.AuthorType = types.AuthorTypeBot
+	}
+
+	if api.MergedAt != nil {
+		pr.MergedAt = &api.MergedAt.Time
 	}
+
+	if api.MergedBy != nil {
+		pr.MergedBy = api.MergedBy.Login
+	}
+
+	if api.Milestone != nil {
+		pr.Milestone = api.Milestone.Title
+	}
+
+	return pr
 }
@@ -12,6 +12,8 @@ const (
 )
 
 type numberedPullRequestQuery struct {
+	rateLimited
+
 	Repository struct {
 		Pr0  *graphqlPullRequest `graphql:"pr0: pullRequest(number: $number0) @include(if: $has0)"`
 		Pr1  *graphqlPullRequest `graphql:"pr1: pullRequest(number: $number1) @include(if: $has1)"`
@@ -334,3 +336,981 @@ func (r *numberedPullRequestQuery) Get(index int) *graphqlPullRequest {
 
 	panic(fmt.Sprintf("Index %d out of range [0,%d] when accessing PR request", index, MaxPullRequestsPerQuery-1))
 }
+
+type numberedPullRequestStampQuery struct {
+	rateLimited
+
+	Repository struct {
+		Pr0  *pullRequestStamp `graphql:"pr0: pullRequest(number: $number0) @include(if: $has0)"`
+		Pr1  *pullRequestStamp `graphql:"pr1: pullRequest(number: $number1) @include(if: $has1)"`
+		Pr2  *pullRequestStamp `graphql:"pr2: pullRequest(number: $number2) @include(if: $has2)"`
+		Pr3  *pullRequestStamp `graphql:"pr3: pullRequest(number: $number3) @include(if: $has3)"`
+		Pr4  *pullRequestStamp `graphql:"pr4: pullRequest(number: $number4) @include(if: $has4)"`
+		Pr5  *pullRequestStamp `graphql:"pr5: pullRequest(number: $number5) @include(if: $has5)"`
+		Pr6  *pullRequestStamp `graphql:"pr6: pullRequest(number: $number6) @include(if: $has6)"`
+		Pr7  *pullRequestStamp `graphql:"pr7: pullRequest(number: $number7) @include(if: $has7)"`
+		Pr8  *pullRequestStamp `graphql:"pr8: pullRequest(number: $number8) @include(if: $has8)"`
+		Pr9  *pullRequestStamp `graphql:"pr9: pullRequest(number: $number9) @include(if: $has9)"`
+		Pr10 *pullRequestStamp `graphql:"pr10: pullRequest(number: $number10) @include(if: $has10)"`
+		Pr11 *pullRequestStamp `graphql:"pr11: pullRequest(number: $number11) @include(if: $has11)"`
+		Pr12 *pullRequestStamp `graphql:"pr12: pullRequest(number: $number12) @include(if: $has12)"`
+		Pr13 *pullRequestStamp `graphql:"pr13: pullRequest(number: $number13) @include(if: $has13)"`
+		Pr14 *pullRequestStamp `graphql:"pr14: pullRequest(number: $number14) @include(if: $has14)"`
+		Pr15 *pullRequestStamp `graphql:"pr15: pullRequest(number: $number15) @include(if: $has15)"`
+		Pr16 *pullRequestStamp `graphql:"pr16: pullRequest(number: $number16) @include(if: $has16)"`
+		Pr17 *pullRequestStamp `graphql:"pr17: pullRequest(number: $number17) @include(if: $has17)"`
+		Pr18 *pullRequestStamp `graphql:"pr18: pullRequest(number: $number18) @include(if: $has18)"`
+		Pr19 *pullRequestStamp `graphql:"pr19: pullRequest(number: $number19) @include(if: $has19)"`
+		Pr20 *pullRequestStamp `graphql:"pr20: pullRequest(number: $number20) @include(if: $has20)"`
+		Pr21 *pullRequestStamp `graphql:"pr21: pullRequest(number: $number21) @include(if: $has21)"`
+		Pr22 *pullRequestStamp `graphql:"pr22: pullRequest(number: $number22) @include(if: $has22)"`
+		Pr23 *pullRequestStamp `graphql:"pr23: pullRequest(number: $number23) @include(if: $has23)"`
+		Pr24 *pullRequestStamp `graphql:"pr24: pullRequest(number: $number24) @include(if: $has24)"`
+		Pr25 *pullRequestStamp `graphql:"pr25: pullRequest(number: $number25) @include(if: $has25)"`
+		Pr26 *pullRequestStamp `graphql:"pr26: pullRequest(number: $number26) @include(if: $has26)"`
+		Pr27 *pullRequestStamp `graphql:"pr27: pullRequest(number: $number27) @include(if: $has27)"`
+		Pr28 *pullRequestStamp `graphql:"pr28: pullRequest(number: $number28) @include(if: $has28)"`
+		Pr29 *pullRequestStamp `graphql:"pr29: pullRequest(number: $number29) @include(if: $has29)"`
+		Pr30 *pullRequestStamp `graphql:"pr30: pullRequest(number: $number30) @include(if: $has30)"`
+		Pr31 *pullRequestStamp `graphql:"pr31: pullRequest(number: $number31) @include(if: $has31)"`
+		Pr32 *pullRequestStamp `graphql:"pr32: pullRequest(number: $number32) @include(if: $has32)"`
+		Pr33 *pullRequestStamp `graphql:"pr33: pullRequest(number: $number33) @include(if: $has33)"`
+		Pr34 *pullRequestStamp `graphql:"pr34: pullRequest(number: $number34) @include(if: $has34)"`
+		Pr35 *pullRequestStamp `graphql:"pr35: pullRequest(number: $number35) @include(if: $has35)"`
+		Pr36 *pullRequestStamp `graphql:"pr36: pullRequest(number: $number36) @include(if: $has36)"`
+		Pr37 *pullRequestStamp `graphql:"pr37: pullRequest(number: $number37) @include(if: $has37)"`
+		Pr38 *pullRequestStamp `graphql:"pr38: pullRequest(number: $number38) @include(if: $has38)"`
+		Pr39 *pullRequestStamp `graphql:"pr39: pullRequest(number: $number39) @include(if: $has39)"`
+		Pr40 *pullRequestStamp `graphql:"pr40: pullRequest(number: $number40) @include(if: $has40)"`
+		Pr41 *pullRequestStamp `graphql:"pr41: pullRequest(number: $number41) @include(if: $has41)"`
+		Pr42 *pullRequestStamp `graphql:"pr42: pullRequest(number: $number42) @include(if: $has42)"`
+		Pr43 *pullRequestStamp `graphql:"pr43: pullRequest(number: $number43) @include(if: $has43)"`
+		Pr44 *pullRequestStamp `graphql:"pr44: pullRequest(number: $number44) @include(if: $has44)"`
+		Pr45 *pullRequestStamp `graphql:"pr45: pullRequest(number: $number45) @include(if: $has45)"`
+		Pr46 *pullRequestStamp `graphql:"pr46: pullRequest(number: $number46) @include(if: $has46)"`
+		Pr47 *pullRequestStamp `graphql:"pr47: pullRequest(number: $number47) @include(if: $has47)"`
+		Pr48 *pullRequestStamp `graphql:"pr48: pullRequest(number: $number48) @include(if: $has48)"`
+		Pr49 *pullRequestStamp `graphql:"pr49: pullRequest(number: $number49) @include(if: $has49)"`
+		Pr50 *pullRequestStamp `graphql:"pr50: pullRequest(number: $number50) @include(if: $has50)"`
+		Pr51 *pullRequestStamp `graphql:"pr51: pullRequest(number: $number51) @include(if: $has51)"`
+		Pr52 *pullRequestStamp `graphql:"pr52: pullRequest(number: $number52) @include(if: $has52)"`
+		Pr53 *pullRequestStamp `graphql:"pr53: pullRequest(number: $number53) @include(if: $has53)"`
+		Pr54 *pullRequestStamp `graphql:"pr54: pullRequest(number: $number54) @include(if: $has54)"`
+		Pr55 *pullRequestStamp `graphql:"pr55: pullRequest(number: $number55) @include(if: $has55)"`
+		Pr56 *pullRequestStamp `graphql:"pr56: pullRequest(number: $number56) @include(if: $has56)"`
+		Pr57 *pullRequestStamp `graphql:"pr57: pullRequest(number: $number57) @include(if: $has57)"`
+		Pr58 *pullRequestStamp `graphql:"pr58: pullRequest(number: $number58) @include(if: $has58)"`
+		Pr59 *pullRequestStamp `graphql:"pr59: pullRequest(number: $number59) @include(if: $has59)"`
+		Pr60 *pullRequestStamp `graphql:"pr60: pullRequest(number: $number60) @include(if: $has60)"`
+		Pr61 *pullRequestStamp `graphql:"pr61: pullRequest(number: $number61) @include(if: $has61)"`
+		Pr62 *pullRequestStamp `graphql:"pr62: pullRequest(number: $number62) @include(if: $has62)"`
+		Pr63 *pullRequestStamp `graphql:"pr63: pullRequest(number: $number63) @include(if: $has63)"`
+		Pr64 *pullRequestStamp `graphql:"pr64: pullRequest(number: $number64) @include(if: $has64)"`
+		Pr65 *pullRequestStamp `graphql:"pr65: pullRequest(number: $number65) @include(if: $has65)"`
+		Pr66 *pullRequestStamp `graphql:"pr66: pullRequest(number: $number66) @include(if: $has66)"`
+		Pr67 *pullRequestStamp `graphql:"pr67: pullRequest(number: $number67) @include(if: $has67)"`
+		Pr68 *pullRequestStamp `graphql:"pr68: pullRequest(number: $number68) @include(if: $has68)"`
+		Pr69 *pullRequestStamp `graphql:"pr69: pullRequest(number: $number69) @include(if: $has69)"`
+		Pr70 *pullRequestStamp `graphql:"pr70: pullRequest(number: $number70) @include(if: $has70)"`
+		Pr71 *pullRequestStamp `graphql:"pr71: pullRequest(number: $number71) @include(if: $has71)"`
+		Pr72 *pullRequestStamp `graphql:"pr72: pullRequest(number: $number72) @include(if: $has72)"`
+		Pr73 *pullRequestStamp `graphql:"pr73: pullRequest(number: $number73) @include(if: $has73)"`
+		Pr74 *pullRequestStamp `graphql:"pr74: pullRequest(number: $number74) @include(if: $has74)"`
+		Pr75 *pullRequestStamp `graphql:"pr75: pullRequest(number: $number75) @include(if: $has75)"`
+		Pr76 *pullRequestStamp `graphql:"pr76: pullRequest(number: $number76) @include(if: $has76)"`
+		Pr77 *pullRequestStamp `graphql:"pr77: pullRequest(number: $number77) @include(if: $has77)"`
+		Pr78 *pullRequestStamp `graphql:"pr78: pullRequest(number: $number78) @include(if: $has78)"`
+		Pr79 *pullRequestStamp `graphql:"pr79: pullRequest(number: $number79) @include(if: $has79)"`
+		Pr80 *pullRequestStamp `graphql:"pr80: pullRequest(number: $number80) @include(if: $has80)"`
+		Pr81 *pullRequestStamp `graphql:"pr81: pullRequest(number: $number81) @include(if: $has81)"`
+		Pr82 *pullRequestStamp `graphql:"pr82: pullRequest(number: $number82) @include(if: $has82)"`
+		Pr83 *pullRequestStamp `graphql:"pr83: pullRequest(number: $number83) @include(if: $has83)"`
+		Pr84 *pullRequestStamp `graphql:"pr84: pullRequest(number: $number84) @include(if: $has84)"`
+		Pr85 *pullRequestStamp `graphql:"pr85: pullRequest(number: $number85) @include(if: $has85)"`
+		Pr86 *pullRequestStamp `graphql:"pr86: pullRequest(number: $number86) @include(if: $has86)"`
+		Pr87 *pullRequestStamp `graphql:"pr87: pullRequest(number: $number87) @include(if: $has87)"`
+		Pr88 *pullRequestStamp `graphql:"pr88: pullRequest(number: $number88) @include(if: $has88)"`
+		Pr89 *pullRequestStamp `graphql:"pr89: pullRequest(number: $number89) @include(if: $has89)"`
+		Pr90 *pullRequestStamp `graphql:"pr90: pullRequest(number: $number90) @include(if: $has90)"`
+		Pr91 *pullRequestStamp `graphql:"pr91: pullRequest(number: $number91) @include(if: $has91)"`
+		Pr92 *pullRequestStamp `graphql:"pr92: pullRequest(number: $number92) @include(if: $has92)"`
+		Pr93 *pullRequestStamp `graphql:"pr93: pullRequest(number: $number93) @include(if: $has93)"`
+		Pr94 *pullRequestStamp `graphql:"pr94: pullRequest(number: $number94) @include(if: $has94)"`
+		Pr95 *pullRequestStamp `graphql:"pr95: pullRequest(number: $number95) @include(if: $has95)"`
+		Pr96 *pullRequestStamp `graphql:"pr96: pullRequest(number: $number96) @include(if: $has96)"`
+		Pr97 *pullRequestStamp `graphql:"pr97: pullRequest(number: $number97) @include(if: $has97)"`
+		Pr98 *pullRequestStamp `graphql:"pr98: pullRequest(number: $number98) @include(if: $has98)"`
+		Pr99 *pullRequestStamp `graphql:"pr99: pullRequest(number: $number99) @include(if: $has99)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (r *numberedPullRequestStampQuery) GetAll() []pullRequestStamp {
+	result := []pullRequestStamp{}
+
+	for i := 0; i < MaxPullRequestsPerQuery; i++ {
+		if pr := r.Get(i); pr != nil {
+			result = append(result, *pr)
+		}
+	}
+
+	return result
+}
+
+func (r *numberedPullRequestStampQuery) Get(index int) *pullRequestStamp {
+	switch index {
+	case 0:
+		return r.Repository.Pr0
+	case 1:
+		return r.Repository.Pr1
+	case 2:
+		return r.Repository.Pr2
+	case 3:
+		return r.Repository.Pr3
+	case 4:
+		return r.Repository.Pr4
+	case 5:
+		return r.Repository.Pr5
+	case 6:
+		return r.Repository.Pr6
+	case 7:
+		return r.Repository.Pr7
+	case 8:
+		return r.Repository.Pr8
+	case 9:
+		return r.Repository.Pr9
+	case 10:
+		return r.Repository.Pr10
+	case 11:
+		return r.Repository.Pr11
+	case 12:
+		return r.Repository.Pr12
+	case 13:
+		return r.Repository.Pr13
+	case 14:
+		return r.Repository.Pr14
+	case 15:
+		return r.Repository.Pr15
+	case 16:
+		return r.Repository.Pr16
+	case 17:
+		return r.Repository.Pr17
+	case 18:
+		return r.Repository.Pr18
+	case 19:
+		return r.Repository.Pr19
+	case 20:
+		return r.Repository.Pr20
+	case 21:
+		return r.Repository.Pr21
+	case 22:
+		return r.Repository.Pr22
+	case 23:
+		return r.Repository.Pr23
+	case 24:
+		return r.Repository.Pr24
+	case 25:
+		return r.Repository.Pr25
+	case 26:
+		return r.Repository.Pr26
+	case 27:
+		return r.Repository.Pr27
+	case 28:
+		return r.Repository.Pr28
+	case 29:
+		return r.Repository.Pr29
+	case 30:
+		return r.Repository.Pr30
+	case 31:
+		return r.Repository.Pr31
+	case 32:
+		return r.Repository.Pr32
+	case 33:
+		return r.Repository.Pr33
+	case 34:
+		return r.Repository.Pr34
+	case 35:
+		return r.Repository.Pr35
+	case 36:
+		return r.Repository.Pr36
+	case 37:
+		return r.Repository.Pr37
+	case 38:
+		return r.Repository.Pr38
+	case 39:
+		return r.Repository.Pr39
+	case 40:
+		return r.Repository.Pr40
+	case 41:
+		return r.Repository.Pr41
+	case 42:
+		return r.Repository.Pr42
+	case 43:
+		return r.Repository.Pr43
+	case 44:
+		return r.Repository.Pr44
+	case 45:
+		return r.Repository.Pr45
+	case 46:
+		return r.Repository.Pr46
+	case 47:
+		return r.Repository.Pr47
+	case 48:
+		return r.Repository.Pr48
+	case 49:
+		return r.Repository.Pr49
+	case 50:
+		return r.Repository.Pr50
+	case 51:
+		return r.Repository.Pr51
+	case 52:
+		return r.Repository.Pr52
+	case 53:
+		return r.Repository.Pr53
+	case 54:
+		return r.Repository.Pr54
+	case 55:
+		return r.Repository.Pr55
+	case 56:
+		return r.Repository.Pr56
+	case 57:
+		return r.Repository.Pr57
+	case 58:
+		return r.Repository.Pr58
+	case 59:
+		return r.Repository.Pr59
+	case 60:
+		return r.Repository.Pr60
+	case 61:
+		return r.Repository.Pr61
+	case 62:
+		return r.Repository.Pr62
+	case 63:
+		return r.Repository.Pr63
+	case 64:
+		return r.Repository.Pr64
+	case 65:
+		return r.Repository.Pr65
+	case 66:
+		return r.Repository.Pr66
+	case 67:
+		return r.Repository.Pr67
+	case 68:
+		return r.Repository.Pr68
+	case 69:
+		return r.Repository.Pr69
+	case 70:
+		return r.Repository.Pr70
+	case 71:
+		return r.Repository.Pr71
+	case 72:
+		return r.Repository.Pr72
+	case 73:
+		return r.Repository.Pr73
+	case 74:
+		return r.Repository.Pr74
+	case 75:
+		return r.Repository.Pr75
+	case 76:
+		return r.Repository.Pr76
+	case 77:
+		return r.Repository.Pr77
+	case 78:
+		return r.Repository.Pr78
+	case 79:
+		return r.Repository.Pr79
+	case 80:
+		return r.Repository.Pr80
+	case 81:
+		return r.Repository.Pr81
+	case 82:
+		return r.Repository.Pr82
+	case 83:
+		return r.Repository.Pr83
+	case 84:
+		return r.Repository.Pr84
+	case 85:
+		return r.Repository.Pr85
+	case 86:
+		return r.Repository.Pr86
+	case 87:
+		return r.Repository.Pr87
+	case 88:
+		return r.Repository.Pr88
+	case 89:
+		return r.Repository.Pr89
+	case 90:
+		return r.Repository.Pr90
+	case 91:
+		return r.Repository.Pr91
+	case 92:
+		return r.Repository.Pr92
+	case 93:
+		return r.Repository.Pr93
+	case 94:
+		return r.Repository.Pr94
+	case 95:
+		return r.Repository.Pr95
+	case 96:
+		return r.Repository.Pr96
+	case 97:
+		return r.Repository.Pr97
+	case 98:
+		return r.Repository.Pr98
+	case 99:
+		return r.Repository.Pr99
+	}
+
+	panic(fmt.Sprintf("Index %d out of range [0,%d] when accessing PR stamp request", index, MaxPullRequestsPerQuery-1))
+}
+
+type numberedPullRequestFilesQuery struct {
+	rateLimited
+
+	Repository struct {
+		Pr0  *pullRequestFiles `graphql:"pr0: pullRequest(number: $number0) @include(if: $has0)"`
+		Pr1  *pullRequestFiles `graphql:"pr1: pullRequest(number: $number1) @include(if: $has1)"`
+		Pr2  *pullRequestFiles `graphql:"pr2: pullRequest(number: $number2) @include(if: $has2)"`
+		Pr3  *pullRequestFiles `graphql:"pr3: pullRequest(number: $number3) @include(if: $has3)"`
+		Pr4  *pullRequestFiles `graphql:"pr4: pullRequest(number: $number4) @include(if: $has4)"`
+		Pr5  *pullRequestFiles `graphql:"pr5: pullRequest(number: $number5) @include(if: $has5)"`
+		Pr6  *pullRequestFiles `graphql:"pr6: pullRequest(number: $number6) @include(if: $has6)"`
+		Pr7  *pullRequestFiles `graphql:"pr7: pullRequest(number: $number7) @include(if: $has7)"`
+		Pr8  *pullRequestFiles `graphql:"pr8: pullRequest(number: $number8) @include(if: $has8)"`
+		Pr9  *pullRequestFiles `graphql:"pr9: pullRequest(number: $number9) @include(if: $has9)"`
+		Pr10 *pullRequestFiles `graphql:"pr10: pullRequest(number: $number10) @include(if: $has10)"`
+		Pr11 *pullRequestFiles `graphql:"pr11: pullRequest(number: $number11) @include(if: $has11)"`
+		Pr12 *pullRequestFiles `graphql:"pr12: pullRequest(number: $number12) @include(if: $has12)"`
+		Pr13 *pullRequestFiles `graphql:"pr13: pullRequest(number: $number13) @include(if: $has13)"`
+		Pr14 *pullRequestFiles `graphql:"pr14: pullRequest(number: $number14) @include(if: $has14)"`
+		Pr15 *pullRequestFiles `graphql:"pr15: pullRequest(number: $number15) @include(if: $has15)"`
+		Pr16 *pullRequestFiles `graphql:"pr16: pullRequest(number: $number16) @include(if: $has16)"`
+		Pr17 *pullRequestFiles `graphql:"pr17: pullRequest(number: $number17) @include(if: $has17)"`
+		Pr18 *pullRequestFiles `graphql:"pr18: pullRequest(number: $number18) @include(if: $has18)"`
+		Pr19 *pullRequestFiles `graphql:"pr19: pullRequest(number: $number19) @include(if: $has19)"`
+		Pr20 *pullRequestFiles `graphql:"pr20: pullRequest(number: $number20) @include(if: $has20)"`
+		Pr21 *pullRequestFiles `graphql:"pr21: pullRequest(number: $number21) @include(if: $has21)"`
+		Pr22 *pullRequestFiles `graphql:"pr22: pullRequest(number: $number22) @include(if: $has22)"`
+		Pr23 *pullRequestFiles `graphql:"pr23: pullRequest(number: $number23) @include(if: $has23)"`
+		Pr24 *pullRequestFiles `graphql:"pr24: pullRequest(number: $number24) @include(if: $has24)"`
+		Pr25 *pullRequestFiles `graphql:"pr25: pullRequest(number: $number25) @include(if: $has25)"`
+		Pr26 *pullRequestFiles `graphql:"pr26: pullRequest(number: $number26) @include(if: $has26)"`
+		Pr27 *pullRequestFiles `graphql:"pr27: pullRequest(number: $number27) @include(if: $has27)"`
+		Pr28 *pullRequestFiles `graphql:"pr28: pullRequest(number: $number28) @include(if: $has28)"`
+		Pr29 *pullRequestFiles `graphql:"pr29: pullRequest(number: $number29) @include(if: $has29)"`
+		Pr30 *pullRequestFiles `graphql:"pr30: pullRequest(number: $number30) @include(if: $has30)"`
+		Pr31 *pullRequestFiles `graphql:"pr31: pullRequest(number: $number31) @include(if: $has31)"`
+		Pr32 *pullRequestFiles `graphql:"pr32: pullRequest(number: $number32) @include(if: $has32)"`
+		Pr33 *pullRequestFiles `graphql:"pr33: pullRequest(number: $number33) @include(if: $has33)"`
+		Pr34 *pullRequestFiles `graphql:"pr34: pullRequest(number: $number34) @include(if: $has34)"`
+		Pr35 *pullRequestFiles `graphql:"pr35: pullRequest(number: $number35) @include(if: $has35)"`
+		Pr36 *pullRequestFiles `graphql:"pr36: pullRequest(number: $number36) @include(if: $has36)"`
+		Pr37 *pullRequestFiles `graphql:"pr37: pullRequest(number: $number37) @include(if: $has37)"`
+		Pr38 *pullRequestFiles `graphql:"pr38: pullRequest(number: $number38) @include(if: $has38)"`
+		Pr39 *pullRequestFiles `graphql:"pr39: pullRequest(number: $number39) @include(if: $has39)"`
+		Pr40 *pullRequestFiles `graphql:"pr40: pullRequest(number: $number40) @include(if: $has40)"`
+		Pr41 *pullRequestFiles `graphql:"pr41: pullRequest(number: $number41) @include(if: $has41)"`
+		Pr42 *pullRequestFiles `graphql:"pr42: pullRequest(number: $number42) @include(if: $has42)"`
+		Pr43 *pullRequestFiles `graphql:"pr43: pullRequest(number: $number43) @include(if: $has43)"`
+		Pr44 *pullRequestFiles `graphql:"pr44: pullRequest(number: $number44) @include(if: $has44)"`
+		Pr45 *pullRequestFiles `graphql:"pr45: pullRequest(number: $number45) @include(if: $has45)"`
+		Pr46 *pullRequestFiles `graphql:"pr46: pullRequest(number: $number46) @include(if: $has46)"`
+		Pr47 *pullRequestFiles `graphql:"pr47: pullRequest(number: $number47) @include(if: $has47)"`
+		Pr48 *pullRequestFiles `graphql:"pr48: pullRequest(number: $number48) @include(if: $has48)"`
+		Pr49 *pullRequestFiles `graphql:"pr49: pullRequest(number: $number49) @include(if: $has49)"`
+		Pr50 *pullRequestFiles `graphql:"pr50: pullRequest(number: $number50) @include(if: $has50)"`
+		Pr51 *pullRequestFiles `graphql:"pr51: pullRequest(number: $number51) @include(if: $has51)"`
+		Pr52 *pullRequestFiles `graphql:"pr52: pullRequest(number: $number52) @include(if: $has52)"`
+		Pr53 *pullRequestFiles `graphql:"pr53: pullRequest(number: $number53) @include(if: $has53)"`
+		Pr54 *pullRequestFiles `graphql:"pr54: pullRequest(number: $number54) @include(if: $has54)"`
+		Pr55 *pullRequestFiles `graphql:"pr55: pullRequest(number: $number55) @include(if: $has55)"`
+		Pr56 *pullRequestFiles `graphql:"pr56: pullRequest(number: $number56) @include(if: $has56)"`
+		Pr57 *pullRequestFiles `graphql:"pr57: pullRequest(number: $number57) @include(if: $has57)"`
+		Pr58 *pullRequestFiles `graphql:"pr58: pullRequest(number: $number58) @include(if: $has58)"`
+		Pr59 *pullRequestFiles `graphql:"pr59: pullRequest(number: $number59) @include(if: $has59)"`
+		Pr60 *pullRequestFiles `graphql:"pr60: pullRequest(number: $number60) @include(if: $has60)"`
+		Pr61 *pullRequestFiles `graphql:"pr61: pullRequest(number: $number61) @include(if: $has61)"`
+		Pr62 *pullRequestFiles `graphql:"pr62: pullRequest(number: $number62) @include(if: $has62)"`
+		Pr63 *pullRequestFiles `graphql:"pr63: pullRequest(number: $number63) @include(if: $has63)"`
+		Pr64 *pullRequestFiles `graphql:"pr64: pullRequest(number: $number64) @include(if: $has64)"`
+		Pr65 *pullRequestFiles `graphql:"pr65: pullRequest(number: $number65) @include(if: $has65)"`
+		Pr66 *pullRequestFiles `graphql:"pr66: pullRequest(number: $number66) @include(if: $has66)"`
+		Pr67 *pullRequestFiles `graphql:"pr67: pullRequest(number: $number67) @include(if: $has67)"`
+		Pr68 *pullRequestFiles `graphql:"pr68: pullRequest(number: $number68) @include(if: $has68)"`
+		Pr69 *pullRequestFiles `graphql:"pr69: pullRequest(number: $number69) @include(if: $has69)"`
+		Pr70 *pullRequestFiles `graphql:"pr70: pullRequest(number: $number70) @include(if: $has70)"`
+		Pr71 *pullRequestFiles `graphql:"pr71: pullRequest(number: $number71) @include(if: $has71)"`
+		Pr72 *pullRequestFiles `graphql:"pr72: pullRequest(number: $number72) @include(if: $has72)"`
+		Pr73 *pullRequestFiles `graphql:"pr73: pullRequest(number: $number73) @include(if: $has73)"`
+		Pr74 *pullRequestFiles `graphql:"pr74: pullRequest(number: $number74) @include(if: $has74)"`
+		Pr75 *pullRequestFiles `graphql:"pr75: pullRequest(number: $number75) @include(if: $has75)"`
+		Pr76 *pullRequestFiles `graphql:"pr76: pullRequest(number: $number76) @include(if: $has76)"`
+		Pr77 *pullRequestFiles `graphql:"pr77: pullRequest(number: $number77) @include(if: $has77)"`
+		Pr78 *pullRequestFiles `graphql:"pr78: pullRequest(number: $number78) @include(if: $has78)"`
+		Pr79 *pullRequestFiles `graphql:"pr79: pullRequest(number: $number79) @include(if: $has79)"`
+		Pr80 *pullRequestFiles `graphql:"pr80: pullRequest(number: $number80) @include(if: $has80)"`
+		Pr81 *pullRequestFiles `graphql:"pr81: pullRequest(number: $number81) @include(if: $has81)"`
+		Pr82 *pullRequestFiles `graphql:"pr82: pullRequest(number: $number82) @include(if: $has82)"`
+		Pr83 *pullRequestFiles `graphql:"pr83: pullRequest(number: $number83) @include(if: $has83)"`
+		Pr84 *pullRequestFiles `graphql:"pr84: pullRequest(number: $number84) @include(if: $has84)"`
+		Pr85 *pullRequestFiles `graphql:"pr85: pullRequest(number: $number85) @include(if: $has85)"`
+		Pr86 *pullRequestFiles `graphql:"pr86: pullRequest(number: $number86) @include(if: $has86)"`
+		Pr87 *pullRequestFiles `graphql:"pr87: pullRequest(number: $number87) @include(if: $has87)"`
+		Pr88 *pullRequestFiles `graphql:"pr88: pullRequest(number: $number88) @include(if: $has88)"`
+		Pr89 *pullRequestFiles `graphql:"pr89: pullRequest(number: $number89) @include(if: $has89)"`
+		Pr90 *pullRequestFiles `graphql:"pr90: pullRequest(number: $number90) @include(if: $has90)"`
+		Pr91 *pullRequestFiles `graphql:"pr91: pullRequest(number: $number91) @include(if: $has91)"`
+		Pr92 *pullRequestFiles `graphql:"pr92: pullRequest(number: $number92) @include(if: $has92)"`
+		Pr93 *pullRequestFiles `graphql:"pr93: pullRequest(number: $number93) @include(if: $has93)"`
+		Pr94 *pullRequestFiles `graphql:"pr94: pullRequest(number: $number94) @include(if: $has94)"`
+		Pr95 *pullRequestFiles `graphql:"pr95: pullRequest(number: $number95) @include(if: $has95)"`
+		Pr96 *pullRequestFiles `graphql:"pr96: pullRequest(number: $number96) @include(if: $has96)"`
+		Pr97 *pullRequestFiles `graphql:"pr97: pullRequest(number: $number97) @include(if: $has97)"`
+		Pr98 *pullRequestFiles `graphql:"pr98: pullRequest(number: $number98) @include(if: $has98)"`
+		Pr99 *pullRequestFiles `graphql:"pr99: pullRequest(number: $number99) @include(if: $has99)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (r *numberedPullRequestFilesQuery) GetAll() []pullRequestFiles {
+	result := []pullRequestFiles{}
+
+	for i := 0; i < MaxPullRequestsPerQuery; i++ {
+		if pr := r.Get(i); pr != nil {
+			result = append(result, *pr)
+		}
+	}
+
+	return result
+}
+
+func (r *numberedPullRequestFilesQuery) Get(index int) *pullRequestFiles {
+	switch index {
+	case 0:
+		return r.Repository.Pr0
+	case 1:
+		return r.Repository.Pr1
+	case 2:
+		return r.Repository.Pr2
+	case 3:
+		return r.Repository.Pr3
+	case 4:
+		return r.Repository.Pr4
+	case 5:
+		return r.Repository.Pr5
+	case 6:
+		return r.Repository.Pr6
+	case 7:
+		return r.Repository.Pr7
+	case 8:
+		return r.Repository.Pr8
+	case 9:
+		return r.Repository.Pr9
+	case 10:
+		return r.Repository.Pr10
+	case 11:
+		return r.Repository.Pr11
+	case 12:
+		return r.Repository.Pr12
+	case 13:
+		return r.Repository.Pr13
+	case 14:
+		return r.Repository.Pr14
+	case 15:
+		return r.Repository.Pr15
+	case 16:
+		return r.Repository.Pr16
+	case 17:
+		return r.Repository.Pr17
+	case 18:
+		return r.Repository.Pr18
+	case 19:
+		return r.Repository.Pr19
+	case 20:
+		return r.Repository.Pr20
+	case 21:
+		return r.Repository.Pr21
+	case 22:
+		return r.Repository.Pr22
+	case 23:
+		return r.Repository.Pr23
+	case 24:
+		return r.Repository.Pr24
+	case 25:
+		return r.Repository.Pr25
+	case 26:
+		return r.Repository.Pr26
+	case 27:
+		return r.Repository.Pr27
+	case 28:
+		return r.Repository.Pr28
+	case 29:
+		return r.Repository.Pr29
+	case 30:
+		return r.Repository.Pr30
+	case 31:
+		return r.Repository.Pr31
+	case 32:
+		return r.Repository.Pr32
+	case 33:
+		return r.Repository.Pr33
+	case 34:
+		return r.Repository.Pr34
+	case 35:
+		return r.Repository.Pr35
+	case 36:
+		return r.Repository.Pr36
+	case 37:
+		return r.Repository.Pr37
+	case 38:
+		return r.Repository.Pr38
+	case 39:
+		return r.Repository.Pr39
+	case 40:
+		return r.Repository.Pr40
+	case 41:
+		return r.Repository.Pr41
+	case 42:
+		return r.Repository.Pr42
+	case 43:
+		return r.Repository.Pr43
+	case 44:
+		return r.Repository.Pr44
+	case 45:
+		return r.Repository.Pr45
+	case 46:
+		return r.Repository.Pr46
+	case 47:
+		return r.Repository.Pr47
+	case 48:
+		return r.Repository.Pr48
+	case 49:
+		return r.Repository.Pr49
+	case 50:
+		return r.Repository.Pr50
+	case 51:
+		return r.Repository.Pr51
+	case 52:
+		return r.Repository.Pr52
+	case 53:
+		return r.Repository.Pr53
+	case 54:
+		return r.Repository.Pr54
+	case 55:
+		return r.Repository.Pr55
+	case 56:
+		return r.Repository.Pr56
+	case 57:
+		return r.Repository.Pr57
+	case 58:
+		return r.Repository.Pr58
+	case 59:
+		return r.Repository.Pr59
+	case 60:
+		return r.Repository.Pr60
+	case 61:
+		return r.Repository.Pr61
+	case 62:
+		return r.Repository.Pr62
+	case 63:
+		return r.Repository.Pr63
+	case 64:
+		return r.Repository.Pr64
+	case 65:
+		return r.Repository.Pr65
+	case 66:
+		return r.Repository.Pr66
+	case 67:
+		return r.Repository.Pr67
+	case 68:
+		return r.Repository.Pr68
+	case 69:
+		return r.Repository.Pr69
+	case 70:
+		return r.Repository.Pr70
+	case 71:
+		return r.Repository.Pr71
+	case 72:
+		return r.Repository.Pr72
+	case 73:
+		return r.Repository.Pr73
+	case 74:
+		return r.Repository.Pr74
+	case 75:
+		return r.Repository.Pr75
+	case 76:
+		return r.Repository.Pr76
+	case 77:
+		return r.Repository.Pr77
+	case 78:
+		return r.Repository.Pr78
+	case 79:
+		return r.Repository.Pr79
+	case 80:
+		return r.Repository.Pr80
+	case 81:
+		return r.Repository.Pr81
+	case 82:
+		return r.Repository.Pr82
+	case 83:
+		return r.Repository.Pr83
+	case 84:
+		return r.Repository.Pr84
+	case 85:
+		return r.Repository.Pr85
+	case 86:
+		return r.Repository.Pr86
+	case 87:
+		return r.Repository.Pr87
+	case 88:
+		return r.Repository.Pr88
+	case 89:
+		return r.Repository.Pr89
+	case 90:
+		return r.Repository.Pr90
+	case 91:
+		return r.Repository.Pr91
+	case 92:
+		return r.Repository.Pr92
+	case 93:
+		return r.Repository.Pr93
+	case 94:
+		return r.Repository.Pr94
+	case 95:
+		return r.Repository.Pr95
+	case 96:
+		return r.Repository.Pr96
+	case 97:
+		return r.Repository.Pr97
+	case 98:
+		return r.Repository.Pr98
+	case 99:
+		return r.Repository.Pr99
+	}
+
+	panic(fmt.Sprintf("Index %d out of range [0,%d] when accessing PR files request", index, MaxPullRequestsPerQuery-1))
+}
+
+type numberedPullRequestIssuesQuery struct {
+	rateLimited
+
+	Repository struct {
+		Pr0  *pullRequestIssues `graphql:"pr0: pullRequest(number: $number0) @include(if: $has0)"`
+		Pr1  *pullRequestIssues `graphql:"pr1: pullRequest(number: $number1) @include(if: $has1)"`
+		Pr2  *pullRequestIssues `graphql:"pr2: pullRequest(number: $number2) @include(if: $has2)"`
+		Pr3  *pullRequestIssues `graphql:"pr3: pullRequest(number: $number3) @include(if: $has3)"`
+		Pr4  *pullRequestIssues `graphql:"pr4: pullRequest(number: $number4) @include(if: $has4)"`
+		Pr5  *pullRequestIssues `graphql:"pr5: pullRequest(number: $number5) @include(if: $has5)"`
+		Pr6  *pullRequestIssues `graphql:"pr6: pullRequest(number: $number6) @include(if: $has6)"`
+		Pr7  *pullRequestIssues `graphql:"pr7: pullRequest(number: $number7) @include(if: $has7)"`
+		Pr8  *pullRequestIssues `graphql:"pr8: pullRequest(number: $number8) @include(if: $has8)"`
+		Pr9  *pullRequestIssues `graphql:"pr9: pullRequest(number: $number9) @include(if: $has9)"`
+		Pr10 *pullRequestIssues `graphql:"pr10: pullRequest(number: $number10) @include(if: $has10)"`
+		Pr11 *pullRequestIssues `graphql:"pr11: pullRequest(number: $number11) @include(if: $has11)"`
+		Pr12 *pullRequestIssues `graphql:"pr12: pullRequest(number: $number12) @include(if: $has12)"`
+		Pr13 *pullRequestIssues `graphql:"pr13: pullRequest(number: $number13) @include(if: $has13)"`
+		Pr14 *pullRequestIssues `graphql:"pr14: pullRequest(number: $number14) @include(if: $has14)"`
+		Pr15 *pullRequestIssues `graphql:"pr15: pullRequest(number: $number15) @include(if: $has15)"`
+		Pr16 *pullRequestIssues `graphql:"pr16: pullRequest(number: $number16) @include(if: $has16)"`
+		Pr17 *pullRequestIssues `graphql:"pr17: pullRequest(number: $number17) @include(if: $has17)"`
+		Pr18 *pullRequestIssues `graphql:"pr18: pullRequest(number: $number18) @include(if: $has18)"`
+		Pr19 *pullRequestIssues `graphql:"pr19: pullRequest(number: $number19) @include(if: $has19)"`
+		Pr20 *pullRequestIssues `graphql:"pr20: pullRequest(number: $number20) @include(if: $has20)"`
+		Pr21 *pullRequestIssues `graphql:"pr21: pullRequest(number: $number21) @include(if: $has21)"`
+		Pr22 *pullRequestIssues `graphql:"pr22: pullRequest(number: $number22) @include(if: $has22)"`
+		Pr23 *pullRequestIssues `graphql:"pr23: pullRequest(number: $number23) @include(if: $has23)"`
+		Pr24 *pullRequestIssues `graphql:"pr24: pullRequest(number: $number24) @include(if: $has24)"`
+		Pr25 *pullRequestIssues `graphql:"pr25: pullRequest(number: $number25) @include(if: $has25)"`
+		Pr26 *pullRequestIssues `graphql:"pr26: pullRequest(number: $number26) @include(if: $has26)"`
+		Pr27 *pullRequestIssues `graphql:"pr27: pullRequest(number: $number27) @include(if: $has27)"`
+		Pr28 *pullRequestIssues `graphql:"pr28: pullRequest(number: $number28) @include(if: $has28)"`
+		Pr29 *pullRequestIssues `graphql:"pr29: pullRequest(number: $number29) @include(if: $has29)"`
+		Pr30 *pullRequestIssues `graphql:"pr30: pullRequest(number: $number30) @include(if: $has30)"`
+		Pr31 *pullRequestIssues `graphql:"pr31: pullRequest(number: $number31) @include(if: $has31)"`
+		Pr32 *pullRequestIssues `graphql:"pr32: pullRequest(number: $number32) @include(if: $has32)"`
+		Pr33 *pullRequestIssues `graphql:"pr33: pullRequest(number: $number33) @include(if: $has33)"`
+		Pr34 *pullRequestIssues `graphql:"pr34: pullRequest(number: $number34) @include(if: $has34)"`
+		Pr35 *pullRequestIssues `graphql:"pr35: pullRequest(number: $number35) @include(if: $has35)"`
+		Pr36 *pullRequestIssues `graphql:"pr36: pullRequest(number: $number36) @include(if: $has36)"`
+		Pr37 *pullRequestIssues `graphql:"pr37: pullRequest(number: $number37) @include(if: $has37)"`
+		Pr38 *pullRequestIssues `graphql:"pr38: pullRequest(number: $number38) @include(if: $has38)"`
+		Pr39 *pullRequestIssues `graphql:"pr39: pullRequest(number: $number39) @include(if: $has39)"`
+		Pr40 *pullRequestIssues `graphql:"pr40: pullRequest(number: $number40) @include(if: $has40)"`
+		Pr41 *pullRequestIssues `graphql:"pr41: pullRequest(number: $number41) @include(if: $has41)"`
+		Pr42 *pullRequestIssues `graphql:"pr42: pullRequest(number: $number42) @include(if: $has42)"`
+		Pr43 *pullRequestIssues `graphql:"pr43: pullRequest(number: $number43) @include(if: $has43)"`
+		Pr44 *pullRequestIssues `graphql:"pr44: pullRequest(number: $number44) @include(if: $has44)"`
+		Pr45 *pullRequestIssues `graphql:"pr45: pullRequest(number: $number45) @include(if: $has45)"`
+		Pr46 *pullRequestIssues `graphql:"pr46: pullRequest(number: $number46) @include(if: $has46)"`
+		Pr47 *pullRequestIssues `graphql:"pr47: pullRequest(number: $number47) @include(if: $has47)"`
+		Pr48 *pullRequestIssues `graphql:"pr48: pullRequest(number: $number48) @include(if: $has48)"`
+		Pr49 *pullRequestIssues `graphql:"pr49: pullRequest(number: $number49) @include(if: $has49)"`
+		Pr50 *pullRequestIssues `graphql:"pr50: pullRequest(number: $number50) @include(if: $has50)"`
+		Pr51 *pullRequestIssues `graphql:"pr51: pullRequest(number: $number51) @include(if: $has51)"`
+		Pr52 *pullRequestIssues `graphql:"pr52: pullRequest(number: $number52) @include(if: $has52)"`
+		Pr53 *pullRequestIssues `graphql:"pr53: pullRequest(number: $number53) @include(if: $has53)"`
+		Pr54 *pullRequestIssues `graphql:"pr54: pullRequest(number: $number54) @include(if: $has54)"`
+		Pr55 *pullRequestIssues `graphql:"pr55: pullRequest(number: $number55) @include(if: $has55)"`
+		Pr56 *pullRequestIssues `graphql:"pr56: pullRequest(number: $number56) @include(if: $has56)"`
+		Pr57 *pullRequestIssues `graphql:"pr57: pullRequest(number: $number57) @include(if: $has57)"`
+		Pr58 *pullRequestIssues `graphql:"pr58: pullRequest(number: $number58) @include(if: $has58)"`
+		Pr59 *pullRequestIssues `graphql:"pr59: pullRequest(number: $number59) @include(if: $has59)"`
+		Pr60 *pullRequestIssues `graphql:"pr60: pullRequest(number: $number60) @include(if: $has60)"`
+		Pr61 *pullRequestIssues `graphql:"pr61: pullRequest(number: $number61) @include(if: $has61)"`
+		Pr62 *pullRequestIssues `graphql:"pr62: pullRequest(number: $number62) @include(if: $has62)"`
+		Pr63 *pullRequestIssues `graphql:"pr63: pullRequest(number: $number63) @include(if: $has63)"`
+		Pr64 *pullRequestIssues `graphql:"pr64: pullRequest(number: $number64) @include(if: $has64)"`
+		Pr65 *pullRequestIssues `graphql:"pr65: pullRequest(number: $number65) @include(if: $has65)"`
+		Pr66 *pullRequestIssues `graphql:"pr66: pullRequest(number: $number66) @include(if: $has66)"`
+		Pr67 *pullRequestIssues `graphql:"pr67: pullRequest(number: $number67) @include(if: $has67)"`
+		Pr68 *pullRequestIssues `graphql:"pr68: pullRequest(number: $number68) @include(if: $has68)"`
+		Pr69 *pullRequestIssues `graphql:"pr69: pullRequest(number: $number69) @include(if: $has69)"`
+		Pr70 *pullRequestIssues `graphql:"pr70: pullRequest(number: $number70) @include(if: $has70)"`
+		Pr71 *pullRequestIssues `graphql:"pr71: pullRequest(number: $number71) @include(if: $has71)"`
+		Pr72 *pullRequestIssues `graphql:"pr72: pullRequest(number: $number72) @include(if: $has72)"`
+		Pr73 *pullRequestIssues `graphql:"pr73: pullRequest(number: $number73) @include(if: $has73)"`
+		Pr74 *pullRequestIssues `graphql:"pr74: pullRequest(number: $number74) @include(if: $has74)"`
+		Pr75 *pullRequestIssues `graphql:"pr75: pullRequest(number: $number75) @include(if: $has75)"`
+		Pr76 *pullRequestIssues `graphql:"pr76: pullRequest(number: $number76) @include(if: $has76)"`
+		Pr77 *pullRequestIssues `graphql:"pr77: pullRequest(number: $number77) @include(if: $has77)"`
+		Pr78 *pullRequestIssues `graphql:"pr78: pullRequest(number: $number78) @include(if: $has78)"`
+		Pr79 *pullRequestIssues `graphql:"pr79: pullRequest(number: $number79) @include(if: $has79)"`
+		Pr80 *pullRequestIssues `graphql:"pr80: pullRequest(number: $number80) @include(if: $has80)"`
+		Pr81 *pullRequestIssues `graphql:"pr81: pullRequest(number: $number81) @include(if: $has81)"`
+		Pr82 *pullRequestIssues `graphql:"pr82: pullRequest(number: $number82) @include(if: $has82)"`
+		Pr83 *pullRequestIssues `graphql:"pr83: pullRequest(number: $number83) @include(if: $has83)"`
+		Pr84 *pullRequestIssues `graphql:"pr84: pullRequest(number: $number84) @include(if: $has84)"`
+		Pr85 *pullRequestIssues `graphql:"pr85: pullRequest(number: $number85) @include(if: $has85)"`
+		Pr86 *pullRequestIssues `graphql:"pr86: pullRequest(number: $number86) @include(if: $has86)"`
+		Pr87 *pullRequestIssues `graphql:"pr87: pullRequest(number: $number87) @include(if: $has87)"`
+		Pr88 *pullRequestIssues `graphql:"pr88: pullRequest(number: $number88) @include(if: $has88)"`
+		Pr89 *pullRequestIssues `graphql:"pr89: pullRequest(number: $number89) @include(if: $has89)"`
+		Pr90 *pullRequestIssues `graphql:"pr90: pullRequest(number: $number90) @include(if: $has90)"`
+		Pr91 *pullRequestIssues `graphql:"pr91: pullRequest(number: $number91) @include(if: $has91)"`
+		Pr92 *pullRequestIssues `graphql:"pr92: pullRequest(number: $number92) @include(if: $has92)"`
+		Pr93 *pullRequestIssues `graphql:"pr93: pullRequest(number: $number93) @include(if: $has93)"`
+		Pr94 *pullRequestIssues `graphql:"pr94: pullRequest(number: $number94) @include(if: $has94)"`
+		Pr95 *pullRequestIssues `graphql:"pr95: pullRequest(number: $number95) @include(if: $has95)"`
+		Pr96 *pullRequestIssues `graphql:"pr96: pullRequest(number: $number96) @include(if: $has96)"`
+		Pr97 *pullRequestIssues `graphql:"pr97: pullRequest(number: $number97) @include(if: $has97)"`
+		Pr98 *pullRequestIssues `graphql:"pr98: pullRequest(number: $number98) @include(if: $has98)"`
+		Pr99 *pullRequestIssues `graphql:"pr99: pullRequest(number: $number99) @include(if: $has99)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (r *numberedPullRequestIssuesQuery) GetAll() []pullRequestIssues {
+	result := []pullRequestIssues{}
+
+	for i := 0; i < MaxPullRequestsPerQuery; i++ {
+		if pr := r.Get(i); pr != nil {
+			result = append(result, *pr)
+		}
+	}
+
+	return result
+}
+
+func (r *numberedPullRequestIssuesQuery) Get(index int) *pullRequestIssues {
+	switch index {
+	case 0:
+		return r.Repository.Pr0
+	case 1:
+		return r.Repository.Pr1
+	case 2:
+		return r.Repository.Pr2
+	case 3:
+		return r.Repository.Pr3
+	case 4:
+		return r.Repository.Pr4
+	case 5:
+		return r.Repository.Pr5
+	case 6:
+		return r.Repository.Pr6
+	case 7:
+		return r.Repository.Pr7
+	case 8:
+		return r.Repository.Pr8
+	case 9:
+		return r.Repository.Pr9
+	case 10:
+		return r.Repository.Pr10
+	case 11:
+		return r.Repository.Pr11
+	case 12:
+		return r.Repository.Pr12
+	case 13:
+		return r.Repository.Pr13
+	case 14:
+		return r.Repository.Pr14
+	case 15:
+		return r.Repository.Pr15
+	case 16:
+		return r.Repository.Pr16
+	case 17:
+		return r.Repository.Pr17
+	case 18:
+		return r.Repository.Pr18
+	case 19:
+		return r.Repository.Pr19
+	case 20:
+		return r.Repository.Pr20
+	case 21:
+		return r.Repository.Pr21
+	case 22:
+		return r.Repository.Pr22
+	case 23:
+		return r.Repository.Pr23
+	case 24:
+		return r.Repository.Pr24
+	case 25:
+		return r.Repository.Pr25
+	case 26:
+		return r.Repository.Pr26
+	case 27:
+		return r.Repository.Pr27
+	case 28:
+		return r.Repository.Pr28
+	case 29:
+		return r.Repository.Pr29
+	case 30:
+		return r.Repository.Pr30
+	case 31:
+		return r.Repository.Pr31
+	case 32:
+		return r.Repository.Pr32
+	case 33:
+		return r.Repository.Pr33
+	case 34:
+		return r.Repository.Pr34
+	case 35:
+		return r.Repository.Pr35
+	case 36:
+		return r.Repository.Pr36
+	case 37:
+		return r.Repository.Pr37
+	case 38:
+		return r.Repository.Pr38
+	case 39:
+		return r.Repository.Pr39
+	case 40:
+		return r.Repository.Pr40
+	case 41:
+		return r.Repository.Pr41
+	case 42:
+		return r.Repository.Pr42
+	case 43:
+		return r.Repository.Pr43
+	case 44:
+		return r.Repository.Pr44
+	case 45:
+		return r.Repository.Pr45
+	case 46:
+		return r.Repository.Pr46
+	case 47:
+		return r.Repository.Pr47
+	case 48:
+		return r.Repository.Pr48
+	case 49:
+		return r.Repository.Pr49
+	case 50:
+		return r.Repository.Pr50
+	case 51:
+		return r.Repository.Pr51
+	case 52:
+		return r.Repository.Pr52
+	case 53:
+		return r.Repository.Pr53
+	case 54:
+		return r.Repository.Pr54
+	case 55:
+		return r.Repository.Pr55
+	case 56:
+		return r.Repository.Pr56
+	case 57:
+		return r.Repository.Pr57
+	case 58:
+		return r.Repository.Pr58
+	case 59:
+		return r.Repository.Pr59
+	case 60:
+		return r.Repository.Pr60
+	case 61:
+		return r.Repository.Pr61
+	case 62:
+		return r.Repository.Pr62
+	case 63:
+		return r.Repository.Pr63
+	case 64:
+		return r.Repository.Pr64
+	case 65:
+		return r.Repository.Pr65
+	case 66:
+		return r.Repository.Pr66
+	case 67:
+		return r.Repository.Pr67
+	case 68:
+		return r.Repository.Pr68
+	case 69:
+		return r.Repository.Pr69
+	case 70:
+		return r.Repository.Pr70
+	case 71:
+		return r.Repository.Pr71
+	case 72:
+		return r.Repository.Pr72
+	case 73:
+		return r.Repository.Pr73
+	case 74:
+		return r.Repository.Pr74
+	case 75:
+		return r.Repository.Pr75
+	case 76:
+		return r.Repository.Pr76
+	case 77:
+		return r.Repository.Pr77
+	case 78:
+		return r.Repository.Pr78
+	case 79:
+		return r.Repository.Pr79
+	case 80:
+		return r.Repository.Pr80
+	case 81:
+		return r.Repository.Pr81
+	case 82:
+		return r.Repository.Pr82
+	case 83:
+		return r.Repository.Pr83
+	case 84:
+		return r.Repository.Pr84
+	case 85:
+		return r.Repository.Pr85
+	case 86:
+		return r.Repository.Pr86
+	case 87:
+		return r.Repository.Pr87
+	case 88:
+		return r.Repository.Pr88
+	case 89:
+		return r.Repository.Pr89
+	case 90:
+		return r.Repository.Pr90
+	case 91:
+		return r.Repository.Pr91
+	case 92:
+		return r.Repository.Pr92
+	case 93:
+		return r.Repository.Pr93
+	case 94:
+		return r.Repository.Pr94
+	case 95:
+		return r.Repository.Pr95
+	case 96:
+		return r.Repository.Pr96
+	case 97:
+		return r.Repository.Pr97
+	case 98:
+		return r.Repository.Pr98
+	case 99:
+		return r.Repository.Pr99
+	}
+
+	panic(fmt.Sprintf("Index %d out of range [0,%d] when accessing PR issues request", index, MaxPullRequestsPerQuery-1))
+}
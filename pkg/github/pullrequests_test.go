@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestConvertPullRequest(t *testing.T) {
+	var api graphqlPullRequest
+
+	err := json.Unmarshal([]byte(`{
+		"number": 42,
+		"title": "Bump dependencies",
+		"url": "https://github.com/kubermatic/gchl/pull/42",
+		"baseRefName": "main",
+		"headRefName": "dependabot/go_modules/deps",
+		"merged": true,
+		"mergedAt": "2026-01-02T03:04:05Z",
+		"author": {"login": "dependabot"},
+		"mergedBy": {"login": "maintainer"},
+		"milestone": {"title": "v1.2"},
+		"labels": {"nodes": [{"name": "kind/chore"}, {"name": "area/deps"}]}
+	}`), &api)
+	if err != nil {
+		t.Fatalf("Failed to decode pull request: %v", err)
+	}
+
+	// json ignores the graphql tag, so set the type name explicitly
+	api.Author.Typename = "Bot"
+
+	pr := convertPullRequest(api)
+
+	if pr.AuthorType != types.AuthorTypeBot {
+		t.Errorf("Expected author type %q, got %q.", types.AuthorTypeBot, pr.AuthorType)
+	}
+
+	if expected := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC); pr.MergedAt == nil || !pr.MergedAt.Equal(expected) {
+		t.Errorf("Expected merge time %v, got %v.", expected, pr.MergedAt)
+	}
+
+	if pr.MergedBy != "maintainer" || pr.Milestone != "v1.2" || pr.BaseBranch != "main" || pr.HeadBranch != "dependabot/go_modules/deps" {
+		t.Errorf("Pull request was not converted correctly: %+v", pr)
+	}
+
+	if expected := []string{"area/deps", "kind/chore"}; !slices.Equal(expected, pr.Labels) {
+		t.Errorf("Expected labels %v, got %v.", expected, pr.Labels)
+	}
+}
+
+func TestConvertUnmergedPullRequest(t *testing.T) {
+	var api graphqlPullRequest
+	api.Number = 1
+	api.Author.Login = "someone"
+	api.Author.Typename = "User"
+
+	pr := convertPullRequest(api)
+
+	if pr.AuthorType != types.AuthorTypeUser {
+		t.Errorf("Expected author type %q, got %q.", types.AuthorTypeUser, pr.AuthorType)
+	}
+
+	if pr.MergedAt != nil || pr.MergedBy != "" || pr.Milestone != "" {
+		t.Errorf("Expected no merge details, got %+v", pr)
+	}
+}
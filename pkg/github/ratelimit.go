@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
+)
+
+type rateLimit struct {
+	Cost      int
+	Remaining int
+	ResetAt   githubv4.DateTime
+}
+
+// rateLimited is embedded into queries to make GitHub report the current
+// rate limit alongside the query results. The field is a pointer because
+// GitHub Enterprise Server returns null if rate limiting is disabled.
+type rateLimited struct {
+	RateLimit *rateLimit
+}
+
+func (r *rateLimited) rateLimitStatus() *rateLimit {
+	return r.RateLimit
+}
+
+type rateLimitReporter interface {
+	rateLimitStatus() *rateLimit
+}
+
+// rateLimiter keeps track of the remaining GraphQL budget and blocks
+// queries once it has been used up, until the rate limit is reset.
+type rateLimiter struct {
+	lock      sync.Mutex
+	known     bool
+	cost      int
+	remaining int
+	resetAt   time.Time
+	log       logrus.FieldLogger
+}
+
+func newRateLimiter(log logrus.FieldLogger) *rateLimiter {
+	return &rateLimiter{
+		log: log,
+	}
+}
+
+// wait blocks until the budget suffices for another query like the last
+// one, or until the context is cancelled. As queries can run concurrently,
+// the expected cost is reserved until GitHub reports the actual budget.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.lock.Lock()
+
+		cost := max(l.cost, 1)
+		if !l.known || l.remaining >= cost {
+			l.remaining -= cost
+			l.lock.Unlock()
+
+			return nil
+		}
+
+		resetAt := l.resetAt
+		l.lock.Unlock()
+
+		delay := time.Until(resetAt)
+		if delay > 0 {
+			l.log.WithField("reset", resetAt.Format(time.RFC3339)).Warn("Rate limit exhausted, waiting for reset…")
+
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		// the budget is unknown until the next query reports it
+		l.lock.Lock()
+		if !l.resetAt.After(resetAt) {
+			l.known = false
+		}
+		l.lock.Unlock()
+	}
+}
+
+func (l *rateLimiter) update(status *rateLimit) {
+	if status == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.known = true
+	l.cost = status.Cost
+	l.remaining = status.Remaining
+	l.resetAt = status.ResetAt.Time
+
+	l.log.WithFields(logrus.Fields{
+		"cost":      status.Cost,
+		"remaining": status.Remaining,
+	}).Debug("Updated rate limit.")
+}
+
+// query runs a GraphQL query, honoring the rate limit.
+func (c *Client) query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	if err := c.limiter.wait(ctx); err != nil {
+		return err
+	}
+
+	if err := c.client.Query(ctx, q, variables); err != nil {
+		return err
+	}
+
+	if reporter, ok := q.(rateLimitReporter); ok {
+		c.limiter.update(reporter.rateLimitStatus())
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestRateLimiter(t *testing.T) {
+	resetAt := time.Now().Add(100 * time.Millisecond)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"rateLimit": map[string]interface{}{
+					"cost":      1,
+					"remaining": 0,
+					"resetAt":   resetAt,
+				},
+				"repository": map[string]interface{}{},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+
+	variables := getNumberedQueryVariables([]int{1}, MaxPullRequestsPerQuery)
+	variables["owner"] = githubv4.String("kubermatic")
+	variables["name"] = githubv4.String("gchl")
+
+	// the first query uses up the budget
+	var q numberedPullRequestStampQuery
+	if err := client.query(context.Background(), &q, variables); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if q.RateLimit == nil || q.RateLimit.Remaining != 0 {
+		t.Fatalf("Rate limit was not reported: %+v", q.RateLimit)
+	}
+
+	// and the second one has to wait for the reset
+	if err := client.query(context.Background(), &q, variables); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if time.Now().Before(resetAt) {
+		t.Fatal("Expected query to wait until the rate limit has been reset.")
+	}
+
+	// unless the context is cancelled first
+	client.limiter.update(&rateLimit{Cost: 1, ResetAt: githubv4.DateTime{Time: time.Now().Add(time.Hour)}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := client.query(ctx, &q, variables); err == nil {
+		t.Fatal("Expected an error, but got none.")
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// recording is a single GraphQL request and its response, as stored on disk.
+// Headers are deliberately not stored, so that fixtures never contain tokens.
+type recording struct {
+	Request    json.RawMessage `json:"request"`
+	StatusCode int             `json:"statusCode"`
+	Response   json.RawMessage `json:"response"`
+}
+
+// recordingFilename returns the fixture filename for a request key. Since
+// the GraphQL client always sends the same body for the same query and
+// variables, the key's hash identifies a request.
+func recordingFilename(dir string, body []byte) string {
+	hash := sha256.Sum256(body)
+	return filepath.Join(dir, hex.EncodeToString(hash[:])+".json")
+}
+
+// recordingTransport forwards all requests and saves the responses to disk.
+type recordingTransport struct {
+	next http.RoundTripper
+	dir  string
+}
+
+func newRecordingTransport(next http.RoundTripper, dir string) (*recordingTransport, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create record directory: %w", err)
+	}
+
+	return &recordingTransport{
+		next: next,
+		dir:  dir,
+	}, nil
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body = requestKey(req, body)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	rec := recording{
+		Request:    asJSON(body),
+		StatusCode: resp.StatusCode,
+		Response:   asJSON(respBody),
+	}
+
+	content, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(recordingFilename(t.dir, body), content, 0644); err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	return resp, nil
+}
+
+// replayTransport serves previously recorded responses and never talks to
+// the network.
+type replayTransport struct {
+	dir string
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body = requestKey(req, body)
+
+	filename := recordingFilename(t.dir, body)
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("no recorded response for request %s: %w", body, err)
+	}
+
+	var rec recording
+	if err := json.Unmarshal(content, &rec); err != nil {
+		return nil, fmt.Errorf("failed to parse recording %s: %w", filename, err)
+	}
+
+	respBody := []byte(rec.Response)
+
+	var text string
+	if json.Unmarshal(rec.Response, &text) == nil {
+		respBody = []byte(text)
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode: rec.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(respBody)),
+		Request:    req,
+	}, nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request: %w", err)
+	}
+	req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// requestKey identifies a request: GraphQL requests by their body and REST
+// requests without a body by their method and URL.
+func requestKey(req *http.Request, body []byte) []byte {
+	if len(body) > 0 {
+		return body
+	}
+
+	return []byte(req.Method + " " + req.URL.RequestURI())
+}
+
+// asJSON embeds valid JSON as-is to keep fixtures readable and stores
+// everything else (e.g. HTML error pages) as a string.
+func asJSON(data []byte) json.RawMessage {
+	if json.Valid(data) {
+		return data
+	}
+
+	encoded, _ := json.Marshal(string(data))
+
+	return encoded
+}
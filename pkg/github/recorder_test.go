@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	fixtures := t.TempDir()
+
+	fake := &fakePullRequestServer{
+		updatedAt: map[int]time.Time{
+			1: time.Now().UTC().Truncate(time.Second),
+		},
+	}
+
+	server := httptest.NewServer(fake)
+
+	recorder, err := NewClient(ctx, logrus.New(), ClientOptions{
+		URL:       server.URL,
+		Token:     "s3cr3t",
+		RecordDir: fixtures,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	recorded, err := recorder.FetchBatchPullRequests(ctx, "kubermatic", "gchl", []int{1})
+	if err != nil {
+		t.Fatalf("Failed to fetch pull requests: %v", err)
+	}
+
+	// from now on, everything must come from the fixtures
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(fixtures, "*.json"))
+	if err != nil {
+		t.Fatalf("Failed to list fixtures: %v", err)
+	}
+
+	// the schema check, the pull request query and its closed issues
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 fixtures, got %d.", len(files))
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read fixture: %v", err)
+		}
+
+		if strings.Contains(string(content), "s3cr3t") {
+			t.Fatalf("Fixture %s contains the token.", file)
+		}
+	}
+
+	replayer, err := NewClient(ctx, logrus.New(), ClientOptions{
+		URL:       server.URL,
+		ReplayDir: fixtures,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	replayed, err := replayer.FetchBatchPullRequests(ctx, "kubermatic", "gchl", []int{1})
+	if err != nil {
+		t.Fatalf("Failed to replay pull requests: %v", err)
+	}
+
+	if replayed[1].Title != recorded[1].Title {
+		t.Errorf("Expected replayed title %q, got %q.", recorded[1].Title, replayed[1].Title)
+	}
+
+	// requests that have not been recorded must fail
+	if _, err := replayer.FetchBatchPullRequests(ctx, "kubermatic", "gchl", []int{2}); err == nil {
+		t.Error("Expected an error for an unrecorded request, but got none.")
+	}
+}
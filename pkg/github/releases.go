@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/shurcooL/githubv4"
+)
+
+var _ source.ReleaseSource = &Client{}
+
+type releasesQuery struct {
+	rateLimited
+
+	Repository struct {
+		Releases struct {
+			Nodes []struct {
+				Name         string
+				TagName      string
+				IsDraft      bool
+				IsPrerelease bool
+				TagCommit    *struct {
+					OID string
+				}
+			}
+			PageInfo pageInfo
+		} `graphql:"releases(first: 100, after: $cursor)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// Releases returns all GitHub Releases of the repository.
+func (c *Client) Releases(ctx context.Context, owner string, name string) ([]types.Release, error) {
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	releases := []types.Release{}
+
+	for {
+		c.log.Debug("fetchReleases()")
+
+		var q releasesQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("failed to fetch releases: %w", err)
+		}
+
+		for _, node := range q.Repository.Releases.Nodes {
+			release := types.Release{
+				Name:       node.Name,
+				Tag:        node.TagName,
+				Draft:      node.IsDraft,
+				Prerelease: node.IsPrerelease,
+			}
+
+			if node.TagCommit != nil {
+				release.Hash = node.TagCommit.OID
+			}
+
+			releases = append(releases, release)
+		}
+
+		if !q.Repository.Releases.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["cursor"] = q.Repository.Releases.PageInfo.EndCursor
+	}
+
+	return releases, nil
+}
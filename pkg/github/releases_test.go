@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+func TestReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeData(w, map[string]interface{}{
+			"repository": map[string]interface{}{
+				"releases": map[string]interface{}{
+					"nodes": []interface{}{
+						map[string]interface{}{"name": "v1.1.0", "tagName": "v1.1.0", "tagCommit": map[string]string{"oid": "c2"}},
+						map[string]interface{}{"name": "v1.2.0", "tagName": "v1.2.0", "isDraft": true},
+					},
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+
+	releases, err := client.Releases(context.Background(), "kubermatic", "gchl")
+	if err != nil {
+		t.Fatalf("Failed to fetch releases: %v", err)
+	}
+
+	expected := []types.Release{
+		{Name: "v1.1.0", Tag: "v1.1.0", Hash: "c2"},
+		{Name: "v1.2.0", Tag: "v1.2.0", Draft: true},
+	}
+
+	if !slices.Equal(expected, releases) {
+		t.Errorf("Expected releases %+v, got %+v.", expected, releases)
+	}
+}
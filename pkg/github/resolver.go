@@ -44,6 +44,8 @@ type ref struct {
 }
 
 type refsQuery struct {
+	rateLimited
+
 	Repository struct {
 		DefaultBranchRef struct {
 			Name string
@@ -102,7 +104,7 @@ func (c *Client) fetchReferencesPage(ctx context.Context, owner string, name str
 
 	var q refsQuery
 
-	err := c.client.Query(ctx, &q, variables)
+	err := c.query(ctx, &q, variables)
 	if err != nil {
 		return types.RepositoryRefs{}, "", err
 	}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxRetries is the number of times a failed request is retried.
+	maxRetries = 5
+
+	// maxBackoff caps the exponential backoff between retries.
+	maxBackoff = time.Minute
+)
+
+// retryTransport retries requests that failed due to transient errors or
+// secondary rate limits, using a jittered exponential backoff.
+type retryTransport struct {
+	next           http.RoundTripper
+	log            logrus.FieldLogger
+	requestTimeout time.Duration
+	baseBackoff    time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, log logrus.FieldLogger, requestTimeout time.Duration) *retryTransport {
+	return &retryTransport{
+		next:           next,
+		log:            log,
+		requestTimeout: requestTimeout,
+		baseBackoff:    time.Second,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTrip(req, body)
+
+		retry, delay := t.shouldRetry(req.Context(), attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		fields := logrus.Fields{"attempt": attempt + 1, "delay": delay}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.Status
+		}
+		t.log.WithFields(fields).Warn("Request failed, retrying…")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// roundTrip performs a single attempt. The response body is read completely,
+// so that the per-request timeout also covers receiving the response.
+func (t *retryTransport) roundTrip(req *http.Request, body []byte) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.requestTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, t.requestTimeout)
+		defer cancel()
+	}
+
+	attempt := req.Clone(ctx)
+	if body != nil {
+		attempt.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(attempt)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	resp.Request = req
+
+	return resp, nil
+}
+
+// shouldRetry decides whether the attempt should be retried and how long to
+// wait before doing so.
+func (t *retryTransport) shouldRetry(ctx context.Context, attempt int, resp *http.Response, err error) (bool, time.Duration) {
+	if attempt >= maxRetries || ctx.Err() != nil {
+		return false, 0
+	}
+
+	// network errors and per-request timeouts
+	if err != nil {
+		return true, t.backoff(attempt)
+	}
+
+	if !isRetryableResponse(resp) {
+		return false, 0
+	}
+
+	if delay, ok := retryAfter(resp); ok {
+		return true, delay
+	}
+
+	return true, t.backoff(attempt)
+}
+
+func isRetryableResponse(resp *http.Response) bool {
+	switch {
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests:
+		return true
+
+	case resp.StatusCode == http.StatusForbidden:
+		if resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			return true
+		}
+
+		// secondary rate limits (formerly known as abuse detection)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		message := strings.ToLower(string(body))
+
+		return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse")
+	}
+
+	return false
+}
+
+// retryAfter returns the delay requested by GitHub, if any.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return max(time.Until(time.Unix(reset, 0)), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+// backoff returns an exponentially growing delay with jitter, to prevent
+// concurrent clients from retrying in lockstep.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := min(t.baseBackoff<<attempt, maxBackoff)
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRetryTransport(t *testing.T) {
+	testcases := []struct {
+		name     string
+		failures []func(w http.ResponseWriter)
+		requests int32
+		status   int
+	}{
+		{
+			name: "transient server errors are retried",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+			},
+			requests: 3,
+			status:   http.StatusOK,
+		},
+		{
+			name: "secondary rate limits are retried",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = io.WriteString(w, `{"message":"You have exceeded a secondary rate limit."}`)
+				},
+			},
+			requests: 2,
+			status:   http.StatusOK,
+		},
+		{
+			name: "other client errors are not retried",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusUnauthorized) },
+			},
+			requests: 1,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			name: "retries are limited",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			requests: maxRetries + 1,
+			status:   http.StatusBadGateway,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			requests := &atomic.Int32{}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "query" {
+					t.Errorf("Request body was not sent again, got %q.", body)
+				}
+
+				if n := int(requests.Add(1)); n <= len(testcase.failures) {
+					testcase.failures[n-1](w)
+					return
+				}
+
+				_, _ = io.WriteString(w, "ok")
+			}))
+			t.Cleanup(server.Close)
+
+			transport := newRetryTransport(http.DefaultTransport, logrus.New(), time.Second)
+			transport.baseBackoff = time.Millisecond
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("query"))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != testcase.status {
+				t.Errorf("Expected status %d, got %d.", testcase.status, resp.StatusCode)
+			}
+
+			if n := requests.Load(); n != testcase.requests {
+				t.Errorf("Expected %d requests, got %d.", testcase.requests, n)
+			}
+		})
+	}
+}
+
+func TestRetryTransportTimesOutRequests(t *testing.T) {
+	requests := &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// only the first request hangs
+		if requests.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+
+		_, _ = io.WriteString(w, "ok")
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newRetryTransport(http.DefaultTransport, logrus.New(), 50*time.Millisecond)
+	transport.baseBackoff = time.Millisecond
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests, got %d.", n)
+	}
+}
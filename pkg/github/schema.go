@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type schemaType struct {
+	Fields []struct {
+		Name string
+	}
+}
+
+type schemaQuery struct {
+	Commit      *schemaType `graphql:"commit: __type(name: \"Commit\")"`
+	PullRequest *schemaType `graphql:"pullRequest: __type(name: \"PullRequest\")"`
+}
+
+// requiredSchemaFields lists the fields that older GitHub Enterprise Server
+// releases might not support, but that gchl cannot work without.
+var requiredSchemaFields = map[string][]string{
+	"Commit":      {"associatedPullRequests", "history", "message", "messageHeadline", "url"},
+	"PullRequest": {"author", "baseRefName", "body", "headRefName", "labels", "merged", "mergedAt", "mergedBy", "milestone", "number", "title", "url"},
+}
+
+// checkSchema uses GraphQL introspection to ensure the API supports all
+// features that gchl relies on. Optional features are disabled if the API
+// does not support them.
+func (c *Client) checkSchema(ctx context.Context) error {
+	c.log.Debug("checkSchema()")
+
+	var q schemaQuery
+
+	if err := c.query(ctx, &q, nil); err != nil {
+		return fmt.Errorf("failed to query GraphQL schema: %w", err)
+	}
+
+	available := map[string]*schemaType{
+		"Commit":      q.Commit,
+		"PullRequest": q.PullRequest,
+	}
+
+	supported := map[string]sets.Set[string]{}
+	for typeName, t := range available {
+		supported[typeName] = sets.New[string]()
+		if t != nil {
+			for _, field := range t.Fields {
+				supported[typeName].Insert(field.Name)
+			}
+		}
+	}
+
+	missing := []string{}
+	for _, typeName := range sets.List(sets.KeySet(requiredSchemaFields)) {
+		for _, field := range requiredSchemaFields[typeName] {
+			if !supported[typeName].Has(field) {
+				missing = append(missing, typeName+"."+field)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the GitHub API does not support the following GraphQL fields required by gchl, please upgrade your GitHub Enterprise Server: %s", strings.Join(missing, ", "))
+	}
+
+	if !supported["PullRequest"].Has("closingIssuesReferences") {
+		c.closingIssues = false
+		c.log.Warn("The GitHub API does not support PullRequest.closingIssuesReferences, closed issues will not be linked in the changelog.")
+	}
+
+	// changed files are only needed for --component, so the error is
+	// postponed until they are fetched
+	c.changedFiles = supported["PullRequest"].Has("files")
+
+	return nil
+}
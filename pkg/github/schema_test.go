@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newSchemaServer(t *testing.T, commitFields []string, pullRequestFields []string) *httptest.Server {
+	toType := func(fields []string) map[string]interface{} {
+		result := []map[string]string{}
+		for _, field := range fields {
+			result = append(result, map[string]string{"name": field})
+		}
+
+		return map[string]interface{}{"fields": result}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"commit":      toType(commitFields),
+				"pullRequest": toType(pullRequestFields),
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCheckSchema(t *testing.T) {
+	allPullRequestFields := append(slices.Clone(requiredSchemaFields["PullRequest"]), "closingIssuesReferences", "files")
+
+	testcases := []struct {
+		name              string
+		commitFields      []string
+		pullRequestFields []string
+		missing           string
+		closingIssues     bool
+		changedFiles      bool
+	}{
+		{
+			name:              "all fields are supported",
+			commitFields:      requiredSchemaFields["Commit"],
+			pullRequestFields: allPullRequestFields,
+			closingIssues:     true,
+			changedFiles:      true,
+		},
+		{
+			name:              "optional fields are not supported",
+			commitFields:      requiredSchemaFields["Commit"],
+			pullRequestFields: requiredSchemaFields["PullRequest"],
+			closingIssues:     false,
+			changedFiles:      false,
+		},
+		{
+			name:              "associated pull requests are not supported",
+			commitFields:      []string{"history", "messageHeadline"},
+			pullRequestFields: allPullRequestFields,
+			missing:           "Commit.associatedPullRequests",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			server := newSchemaServer(t, testcase.commitFields, testcase.pullRequestFields)
+
+			client, err := NewClient(context.Background(), logrus.New(), ClientOptions{
+				URL:   server.URL,
+				Token: "test",
+			})
+
+			if testcase.missing == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+
+				if client.closingIssues != testcase.closingIssues {
+					t.Errorf("Expected closing issue support to be %v, got %v.", testcase.closingIssues, client.closingIssues)
+				}
+
+				if client.changedFiles != testcase.changedFiles {
+					t.Errorf("Expected changed files support to be %v, got %v.", testcase.changedFiles, client.changedFiles)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("Expected an error, but got none.")
+			}
+
+			if !strings.Contains(err.Error(), testcase.missing) {
+				t.Fatalf("Expected error to mention %q, got %q.", testcase.missing, err.Error())
+			}
+		})
+	}
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	testcases := map[string]string{
+		"":                             "https://api.github.com/graphql",
+		"https://github.com":           "https://api.github.com/graphql",
+		"https://github.example.com/":  "https://github.example.com/api/graphql",
+		"https://example.com/ghe-path": "https://example.com/ghe-path/api/graphql",
+	}
+
+	for webURL, expected := range testcases {
+		if endpoint := GraphQLEndpoint(webURL); endpoint != expected {
+			t.Errorf("Expected %q to result in %q, got %q.", webURL, expected, endpoint)
+		}
+	}
+}
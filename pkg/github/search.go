@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/shurcooL/githubv4"
+)
+
+var _ source.Searcher = &Client{}
+
+// maxSearchResults is the number of results GitHub returns at most for
+// any search query.
+const maxSearchResults = 1000
+
+type searchPullRequest struct {
+	graphqlPullRequest
+
+	MergeCommit *struct {
+		OID             string
+		MessageHeadline string
+		Message         string
+		URL             string
+	}
+}
+
+type searchPullRequestsQuery struct {
+	rateLimited
+
+	Search struct {
+		IssueCount int
+		Nodes      []struct {
+			PullRequest searchPullRequest `graphql:"... on PullRequest"`
+		}
+		PageInfo pageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 50, after: $cursor)"`
+}
+
+// SearchMergedPullRequests returns the merge commits of all merged pull
+// requests in the repository that match the search query, newest first like
+// the commit history. As GitHub returns at most 1000 results for any search,
+// queries matching more pull requests are rejected.
+func (c *Client) SearchMergedPullRequests(ctx context.Context, owner string, name string, query string) ([]types.Commit, error) {
+	variables := map[string]interface{}{
+		"query":  githubv4.String(fmt.Sprintf("repo:%s/%s is:pr is:merged %s", owner, name, query)),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	commits := []types.Commit{}
+	cursor := ""
+
+	for {
+		c.log.WithField("cursor", cursor).Debug("searchPullRequests()")
+
+		var q searchPullRequestsQuery
+
+		if err := c.query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("failed to search pull requests: %w", err)
+		}
+
+		if q.Search.IssueCount > maxSearchResults {
+			return nil, fmt.Errorf("search matches %d pull requests, but GitHub returns at most %d results, please narrow down the query", q.Search.IssueCount, maxSearchResults)
+		}
+
+		prs := []graphqlPullRequest{}
+		for _, node := range q.Search.Nodes {
+			prs = append(prs, node.PullRequest.graphqlPullRequest)
+		}
+
+		if err := c.completeLabels(ctx, owner, name, prs); err != nil {
+			return nil, err
+		}
+
+		for i, node := range q.Search.Nodes {
+			pr := convertPullRequest(prs[i])
+
+			commit := types.Commit{
+				Title:       pr.Title,
+				Author:      pr.Author,
+				PullRequest: pr,
+			}
+
+			if mc := node.PullRequest.MergeCommit; mc != nil {
+				commit.Hash = mc.OID
+				commit.Title = mc.MessageHeadline
+				commit.Message = mc.Message
+				commit.URL = mc.URL
+			}
+
+			commits = append(commits, commit)
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor = string(q.Search.PageInfo.EndCursor)
+		variables["cursor"] = githubv4.String(cursor)
+	}
+
+	// the search cannot sort by merge date
+	slices.SortStableFunc(commits, func(a, b types.Commit) int {
+		return compareMergedAt(b.PullRequest.MergedAt, a.PullRequest.MergedAt)
+	})
+
+	if err := c.linkIssues(ctx, owner, name, commitPullRequests(commits)); err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// compareMergedAt orders merge times chronologically, with unmerged pull
+// requests first.
+func compareMergedAt(a *time.Time, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return a.Compare(*b)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSearchMergedPullRequests(t *testing.T) {
+	var queries []string
+
+	// serves two pages with one pull request each
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Query  string  `json:"query"`
+				Cursor *string `json:"cursor"`
+			} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		queries = append(queries, req.Variables.Query)
+
+		number := 1
+		if req.Variables.Cursor != nil {
+			number = 2
+		}
+
+		writeData(w, map[string]interface{}{
+			"search": map[string]interface{}{
+				"issueCount": 2,
+				"nodes": []interface{}{map[string]interface{}{
+					"number":   number,
+					"title":    fmt.Sprintf("PR %d", number),
+					"mergedAt": time.Date(2026, 1, number, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+					"author":   map[string]string{"login": "user"},
+					"labels":   map[string]interface{}{"nodes": []interface{}{}},
+					"mergeCommit": map[string]string{
+						"oid":             fmt.Sprintf("c%d", number),
+						"messageHeadline": fmt.Sprintf("PR %d (#%d)", number, number),
+					},
+				}},
+				"pageInfo": map[string]interface{}{
+					"endCursor":   "page-2",
+					"hasNextPage": number == 1,
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+
+	commits, err := client.SearchMergedPullRequests(context.Background(), "kubermatic", "gchl", `milestone:"v2.22"`)
+	if err != nil {
+		t.Fatalf("Failed to search pull requests: %v", err)
+	}
+
+	if expected := `repo:kubermatic/gchl is:pr is:merged milestone:"v2.22"`; len(queries) != 2 || queries[0] != expected {
+		t.Errorf("Expected two queries for %q, got %v.", expected, queries)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+
+		if commit.Author != "user" || commit.PullRequest.Title != fmt.Sprintf("PR %d", commit.PullRequest.Number) {
+			t.Errorf("Commit was not converted correctly: %+v", commit)
+		}
+	}
+
+	// the newest merge comes first
+	if expected := []string{"c2", "c1"}; !slices.Equal(expected, hashes) {
+		t.Errorf("Expected commits %v, got %v.", expected, hashes)
+	}
+}
+
+func TestSearchMergedPullRequestsWithTooManyResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeData(w, map[string]interface{}{
+			"search": map[string]interface{}{
+				"issueCount": maxSearchResults + 1,
+				"nodes":      []interface{}{},
+				"pageInfo": map[string]interface{}{
+					"endCursor":   "page-2",
+					"hasNextPage": true,
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(server)
+
+	_, err := client.SearchMergedPullRequests(context.Background(), "kubermatic", "gchl", "label:kind/bug")
+	if err == nil {
+		t.Fatal("Expected an error, but got none.")
+	}
+
+	if !strings.Contains(err.Error(), "narrow down the query") {
+		t.Errorf("Expected the error to ask for a narrower query, got %q.", err.Error())
+	}
+}
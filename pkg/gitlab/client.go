@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/source"
+
+	"github.com/sirupsen/logrus"
+)
+
+// perPage is the maximum page size supported by the GitLab API.
+const perPage = 100
+
+// Client talks to the GitLab REST API (v4). Merge requests are treated
+// like GitHub pull requests, using their project-scoped IID as the number.
+type Client struct {
+	baseURL *url.URL
+	token   string
+	client  *http.Client
+	log     logrus.FieldLogger
+
+	// concurrency limits the number of requests that are sent at the same
+	// time when looking up the merge requests of many commits.
+	concurrency int
+}
+
+var (
+	_ source.Source     = &Client{}
+	_ source.MergeBaser = &Client{}
+)
+
+// NewClient creates a new client for the GitLab instance at the given
+// URL (e.g. "https://gitlab.example.com"), without the "/api/v4" suffix. Up to
+// concurrency requests are sent at the same time (parallel.DefaultConcurrency
+// if not positive).
+func NewClient(log logrus.FieldLogger, gitlabURL string, token string, concurrency int) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(gitlabURL, "/") + "/api/v4/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitLab URL: %w", err)
+	}
+
+	c := &Client{
+		baseURL: base,
+		token:   token,
+		client:  http.DefaultClient,
+		log:     log,
+
+		concurrency: concurrency,
+	}
+
+	if c.concurrency <= 0 {
+		c.concurrency = parallel.DefaultConcurrency
+	}
+
+	return c, nil
+}
+
+// projectPath returns the URL-encoded project ID, which GitLab accepts
+// in place of the numeric ID.
+func projectPath(owner string, name string) string {
+	return "projects/" + url.PathEscape(owner+"/"+name)
+}
+
+// get performs a GET request against the API path and decodes the response
+// into dst. The returned string is the next page number, if any.
+func (c *Client) get(ctx context.Context, path string, query url.Values, dst interface{}) (string, error) {
+	endpoint, err := c.baseURL.Parse(path)
+	if err != nil {
+		return "", err
+	}
+
+	// url.Parse would unescape the project path again
+	endpoint.RawPath = c.baseURL.Path + path
+	endpoint.RawQuery = query.Encode()
+
+	c.log.WithField("url", endpoint.String()).Debug("GET")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("non-200 OK status code: %v body: %q", resp.Status, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return resp.Header.Get("X-Next-Page"), nil
+}
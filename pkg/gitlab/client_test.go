@@ -0,0 +1,412 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	testToken   = "s3cr3t"
+	testProject = "/api/v4/projects/kubermatic%2Fgchl"
+)
+
+// fakeGitLab is a minimal stand-in for the GitLab API, serving a single
+// project with the following first-parent history (newest first):
+//
+//	c5  merge commit for !3
+//	c4  squashed commit of !2 (and cherry-picked to release/v0.9 in !4)
+//	c3  direct push, no merge request
+//	c2  squashed commit of !1
+//	c1  initial commit
+type fakeGitLab struct {
+	commits       []commit
+	branches      []ref
+	tags          []ref
+	mergeRequests map[int]mergeRequest
+	commitMRs     map[string][]int
+
+	// lookups are the commits whose merge requests have been requested.
+	lookups     sets.Set[string]
+	lookupsLock sync.Mutex
+}
+
+var mergedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newFakeGitLab() *fakeGitLab {
+	f := &fakeGitLab{
+		commits: []commit{
+			{ID: "c5", Title: "Merge branch 'feature' into 'main'", Message: "Merge branch 'feature' into 'main'\n\nAdd feature\n\nSee merge request kubermatic/gchl!3"},
+			{ID: "c4", Title: "Fix bug", Message: "Fix bug"},
+			{ID: "c3", Title: "Direct push", Message: "Direct push"},
+			{ID: "c2", Title: "Add docs", Message: "Add docs"},
+			{ID: "c1", Title: "Initial commit", Message: "Initial commit"},
+		},
+		mergeRequests: map[int]mergeRequest{},
+		lookups:       sets.New[string](),
+		commitMRs: map[string][]int{
+			"c4": {4, 2},
+			"c2": {1},
+		},
+	}
+
+	for i, labels := range [][]string{{"kind::documentation"}, {"kind::bugfix"}, {"kind::feature", "area::api"}, {"kind::bugfix"}} {
+		mr := mergeRequest{
+			IID:          i + 1,
+			Title:        fmt.Sprintf("MR %d", i+1),
+			Description:  fmt.Sprintf("```release-note\nChange %d\n```", i+1),
+			State:        "merged",
+			TargetBranch: "main",
+			WebURL:       fmt.Sprintf("https://gitlab.example.com/kubermatic/gchl/-/merge_requests/%d", i+1),
+			Labels:       labels,
+		}
+		mr.Author.Username = fmt.Sprintf("user%d", i+1)
+
+		f.mergeRequests[mr.IID] = mr
+	}
+
+	feature := f.mergeRequests[3]
+	feature.SourceBranch = "feature"
+	feature.MergedAt = &mergedAt
+	feature.MergeUser = &user{Username: "maintainer"}
+	feature.Milestone = &struct {
+		Title string `json:"title"`
+	}{Title: "v1.0"}
+	f.mergeRequests[3] = feature
+
+	bump := f.mergeRequests[1]
+	bump.Author.Username = "project_278964_bot_3c5d8d1b6f0a"
+	f.mergeRequests[1] = bump
+
+	cherrypick := f.mergeRequests[4]
+	cherrypick.TargetBranch = "release/v0.9"
+	f.mergeRequests[4] = cherrypick
+
+	f.branches = append(f.branches, newRef("main", "c5"), newRef("release/v1.0", "c2"))
+
+	// enough tags to require pagination
+	for i := 0; i < 150; i++ {
+		f.tags = append(f.tags, newRef(fmt.Sprintf("v1.0.%d", i), "c2"))
+	}
+
+	return f
+}
+
+func newRef(name string, hash string) ref {
+	r := ref{Name: name}
+	r.Commit.ID = hash
+
+	return r
+}
+
+func (f *fakeGitLab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("PRIVATE-TOKEN") != testToken {
+		http.Error(w, `{"message":"401 Unauthorized"}`, http.StatusUnauthorized)
+		return
+	}
+
+	path := r.URL.EscapedPath()
+	if !strings.HasPrefix(path, testProject) {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+
+	switch path = strings.TrimPrefix(path, testProject); {
+	case path == "":
+		writeJSON(w, project{DefaultBranch: "main"})
+
+	case path == "/repository/branches":
+		writePage(w, r, f.branches)
+
+	case path == "/repository/tags":
+		writePage(w, r, f.tags)
+
+	case path == "/repository/commits":
+		if query.Get("first_parent") != "true" {
+			http.Error(w, "expected first_parent", http.StatusBadRequest)
+			return
+		}
+
+		start := slices.IndexFunc(f.commits, func(c commit) bool { return c.ID == query.Get("ref_name") })
+		if start < 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		writePage(w, r, f.commits[start:])
+
+	case strings.HasPrefix(path, "/repository/commits/") && strings.HasSuffix(path, "/merge_requests"):
+		hash := strings.TrimSuffix(strings.TrimPrefix(path, "/repository/commits/"), "/merge_requests")
+
+		f.lookupsLock.Lock()
+		f.lookups.Insert(hash)
+		f.lookupsLock.Unlock()
+
+		result := []mergeRequest{}
+		for _, iid := range f.commitMRs[hash] {
+			result = append(result, f.mergeRequests[iid])
+		}
+
+		writeJSON(w, result)
+
+	case path == "/merge_requests":
+		result := []mergeRequest{}
+		for _, iid := range query["iids[]"] {
+			number, _ := strconv.Atoi(iid)
+			if mr, ok := f.mergeRequests[number]; ok {
+				result = append(result, mr)
+			}
+		}
+
+		writeJSON(w, result)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	if end < len(items) {
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+	}
+
+	writeJSON(w, items[start:end])
+}
+
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func newTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(newFakeGitLab())
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(logrus.New(), server.URL, testToken, 0)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return client
+}
+
+func TestReferences(t *testing.T) {
+	client := newTestClient(t)
+
+	refs, err := client.References(context.Background(), "kubermatic", "gchl")
+	if err != nil {
+		t.Fatalf("Failed to fetch references: %v", err)
+	}
+
+	if refs.DefaultBranch != "main" {
+		t.Errorf("Expected default branch %q, got %q.", "main", refs.DefaultBranch)
+	}
+
+	expectedBranches := []types.Ref{{Name: "main", Hash: "c5"}, {Name: "release/v1.0", Hash: "c2"}}
+	if !slices.Equal(expectedBranches, refs.Branches) {
+		t.Errorf("Expected branches %v, got %v.", expectedBranches, refs.Branches)
+	}
+
+	if len(refs.Tags) != 150 {
+		t.Errorf("Expected 150 tags, got %d.", len(refs.Tags))
+	}
+}
+
+func TestHistory(t *testing.T) {
+	client := newTestClient(t)
+
+	commits, err := client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head:   "c5",
+		Branch: "main",
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "c2", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	if len(commits) != 2 {
+		t.Fatalf("Expected 2 commits, got %d: %+v", len(commits), commits)
+	}
+
+	expected := []struct {
+		hash   string
+		number int
+		author string
+		labels []string
+	}{
+		{hash: "c5", number: 3, author: "user3", labels: []string{"area::api", "kind::feature"}},
+		{hash: "c4", number: 2, author: "user2", labels: []string{"kind::bugfix"}},
+	}
+
+	for i, commit := range commits {
+		if commit.Hash != expected[i].hash {
+			t.Errorf("Commit #%d: expected hash %q, got %q.", i, expected[i].hash, commit.Hash)
+		}
+
+		if commit.PullRequest.Number != expected[i].number {
+			t.Errorf("Commit #%d: expected MR !%d, got !%d.", i, expected[i].number, commit.PullRequest.Number)
+		}
+
+		if commit.Author != expected[i].author {
+			t.Errorf("Commit #%d: expected author %q, got %q.", i, expected[i].author, commit.Author)
+		}
+
+		if !slices.Equal(expected[i].labels, commit.PullRequest.Labels) {
+			t.Errorf("Commit #%d: expected labels %v, got %v.", i, expected[i].labels, commit.PullRequest.Labels)
+		}
+
+		if !strings.Contains(commit.PullRequest.Body, "release-note") {
+			t.Errorf("Commit #%d: description was not mapped to the body: %q", i, commit.PullRequest.Body)
+		}
+	}
+}
+
+func TestHistoryOnlyLooksUpCommitsInRange(t *testing.T) {
+	fake := newFakeGitLab()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(logrus.New(), server.URL, testToken, 0)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.History(context.Background(), "kubermatic", "gchl", types.Range{
+		Head:                 "c5",
+		Branch:               "main",
+		IncludeDirectCommits: true,
+		Stop: func(c types.Commit) (bool, error) {
+			return c.Hash == "c2", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	// c5 mentions its merge request, c2 and c1 are outside of the range
+	if expected := []string{"c3", "c4"}; !slices.Equal(expected, sets.List(fake.lookups)) {
+		t.Errorf("Expected merge requests to be looked up for %v, got %v.", expected, sets.List(fake.lookups))
+	}
+}
+
+func TestLog(t *testing.T) {
+	client := newTestClient(t)
+
+	commits, err := client.Log(context.Background(), "kubermatic", "gchl", "c4", 2)
+	if err != nil {
+		t.Fatalf("Failed to fetch log: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	if expected := []string{"c4", "c3"}; !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes)
+	}
+}
+
+func TestLogAcrossPages(t *testing.T) {
+	fake := newFakeGitLab()
+	for i := 0; i < 300; i++ {
+		fake.commits = append(fake.commits, commit{ID: fmt.Sprintf("old%d", i)})
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(logrus.New(), server.URL, testToken, 0)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	commits, err := client.Log(context.Background(), "kubermatic", "gchl", "c5", 250)
+	if err != nil {
+		t.Fatalf("Failed to fetch log: %v", err)
+	}
+
+	hashes := []string{}
+	for _, commit := range commits {
+		hashes = append(hashes, commit.Hash)
+	}
+
+	expected := []string{}
+	for _, commit := range fake.commits[:250] {
+		expected = append(expected, commit.ID)
+	}
+
+	if !slices.Equal(expected, hashes) {
+		t.Fatalf("Expected the first 250 commits, got %d commits: %v", len(hashes), hashes)
+	}
+}
+
+func TestFetchBatchPullRequests(t *testing.T) {
+	client := newTestClient(t)
+
+	prs, err := client.FetchBatchPullRequests(context.Background(), "kubermatic", "gchl", []int{1, 3, 99})
+	if err != nil {
+		t.Fatalf("Failed to fetch merge requests: %v", err)
+	}
+
+	if len(prs) != 2 {
+		t.Fatalf("Expected 2 merge requests, got %d.", len(prs))
+	}
+
+	if pr := prs[3]; pr.URL != "https://gitlab.example.com/kubermatic/gchl/-/merge_requests/3" || pr.Title != "MR 3" {
+		t.Errorf("Merge request was not converted correctly: %+v", pr)
+	}
+
+	feature := prs[3]
+	if feature.MergedAt == nil || !feature.MergedAt.Equal(mergedAt) {
+		t.Errorf("Expected merge time %v, got %v.", mergedAt, feature.MergedAt)
+	}
+
+	if feature.MergedBy != "maintainer" || feature.Milestone != "v1.0" || feature.BaseBranch != "main" || feature.HeadBranch != "feature" || feature.AuthorType != types.AuthorTypeUser {
+		t.Errorf("Merge request metadata was not converted correctly: %+v", feature)
+	}
+
+	if bump := prs[1]; bump.AuthorType != types.AuthorTypeBot {
+		t.Errorf("Expected access token user to be a bot, got %q.", bump.AuthorType)
+	}
+}
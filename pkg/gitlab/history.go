@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8c.io/gchl/pkg/parallel"
+	"k8c.io/gchl/pkg/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type commit struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	WebURL  string `json:"web_url"`
+}
+
+// History will return all commits, beginning with the head hash, until the stop
+// function returns true. Only the first parents are followed. The stop function
+// is called before the merge requests are looked up, so the commits it is
+// given carry no merge request details.
+func (c *Client) History(ctx context.Context, owner string, name string, rng types.Range) ([]types.Commit, error) {
+	commits := []types.Commit{}
+	page := "1"
+
+	for page != "" {
+		var (
+			err      error
+			finished bool
+			result   []types.Commit
+		)
+
+		result, page, finished, err = c.fetchHistoryPage(ctx, owner, name, rng, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch commits: %w", err)
+		}
+
+		commits = append(commits, result...)
+
+		if finished {
+			break
+		}
+	}
+
+	return commits, nil
+}
+
+func (c *Client) fetchHistoryPage(ctx context.Context, owner string, name string, rng types.Range, page string) ([]types.Commit, string, bool, error) {
+	apiCommits, nextPage, err := c.fetchCommits(ctx, owner, name, rng.Head, page)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	// The stop function only needs the commits themselves, so the range can
+	// be cut off before looking up merge requests for commits outside of it.
+	finished := false
+	for i, apiCommit := range apiCommits {
+		stopped, err := rng.Stop(types.Commit{
+			Hash:    apiCommit.ID,
+			Title:   apiCommit.Title,
+			Message: apiCommit.Message,
+			URL:     apiCommit.WebURL,
+		})
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		if stopped {
+			apiCommits = apiCommits[:i]
+			finished = true
+			break
+		}
+	}
+
+	mergeRequests, err := c.fetchCommitMergeRequests(ctx, owner, name, apiCommits, rng.Branch)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	commits := []types.Commit{}
+	for _, apiCommit := range apiCommits {
+		commit := types.Commit{
+			Hash:    apiCommit.ID,
+			Title:   apiCommit.Title,
+			Message: apiCommit.Message,
+			URL:     apiCommit.WebURL,
+		}
+
+		pr, ok := mergeRequests[apiCommit.ID]
+		if !ok && !rng.IncludeDirectCommits {
+			c.log.WithField("commit", apiCommit.ID).Warn("Commit has no associated merge request.")
+			continue
+		}
+
+		if ok {
+			commit.Author = pr.Author
+			commit.PullRequest = pr
+		}
+
+		commits = append(commits, commit)
+	}
+
+	if finished {
+		nextPage = ""
+	}
+
+	return commits, nextPage, finished, nil
+}
+
+// fetchCommitMergeRequests returns the merge requests of the commits, keyed by
+// their hash. Commits without a merge request are not included in the result.
+func (c *Client) fetchCommitMergeRequests(ctx context.Context, owner string, name string, apiCommits []commit, branch string) (map[string]types.PullRequest, error) {
+	// Merge commits mention the merge request in their message, so all of
+	// them can be fetched at once. Only for the remaining commits (e.g. when
+	// merge requests are squashed without merge commit) we need to ask GitLab.
+	mentioned := sets.New[int]()
+	for _, apiCommit := range apiCommits {
+		if iid := mergeRequestFromMessage(owner, name, apiCommit.Message); iid != 0 {
+			mentioned.Insert(iid)
+		}
+	}
+
+	mergeRequests, err := c.FetchBatchPullRequests(ctx, owner, name, sets.List(mentioned))
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]types.PullRequest{}
+	unmentioned := []string{}
+
+	for _, apiCommit := range apiCommits {
+		if pr, ok := mergeRequests[mergeRequestFromMessage(owner, name, apiCommit.Message)]; ok {
+			result[apiCommit.ID] = pr
+		} else {
+			unmentioned = append(unmentioned, apiCommit.ID)
+		}
+	}
+
+	var lock sync.Mutex
+
+	err = parallel.ForEach(ctx, c.concurrency, unmentioned, func(ctx context.Context, hash string) error {
+		pr, err := c.fetchCommitMergeRequest(ctx, owner, name, hash, branch)
+		if err != nil || pr == nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		result[hash] = *pr
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Log returns up to maxCommits commits, following only the first parents.
+// The log contains all commits, but no merge request details.
+func (c *Client) Log(ctx context.Context, owner string, name string, headHash string, maxCommits int) ([]types.Commit, error) {
+	commits := []types.Commit{}
+	page := "1"
+
+	for page != "" && len(commits) < maxCommits {
+		var (
+			err        error
+			apiCommits []commit
+		)
+
+		// GitLab computes the offset from the page size, so it must not change
+		// between pages; surplus commits are cut off below
+		apiCommits, page, err = c.fetchCommits(ctx, owner, name, headHash, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch commits: %w", err)
+		}
+
+		for _, apiCommit := range apiCommits {
+			commits = append(commits, types.Commit{
+				Hash:  apiCommit.ID,
+				Title: apiCommit.Title,
+			})
+		}
+	}
+
+	if len(commits) > maxCommits {
+		commits = commits[:maxCommits]
+	}
+
+	return commits, nil
+}
+
+func (c *Client) fetchCommits(ctx context.Context, owner string, name string, headHash string, page string) ([]commit, string, error) {
+	query := url.Values{}
+	query.Set("ref_name", headHash)
+	query.Set("first_parent", "true")
+	query.Set("per_page", strconv.Itoa(perPage))
+	query.Set("page", page)
+
+	var commits []commit
+
+	nextPage, err := c.get(ctx, projectPath(owner, name)+"/repository/commits", query, &commits)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return commits, nextPage, nil
+}
+
+func (c *Client) MergeBase(ctx context.Context, owner string, name string, a string, b string) (string, error) {
+	query := url.Values{}
+	query.Add("refs[]", a)
+	query.Add("refs[]", b)
+
+	var base commit
+
+	if _, err := c.get(ctx, projectPath(owner, name)+"/repository/merge_base", query, &base); err != nil {
+		return "", fmt.Errorf("failed to determine merge base of %s and %s: %w", a, b, err)
+	}
+
+	return base.ID, nil
+}
+
+var mergeRequestReferenceRegex = regexp.MustCompile(`See merge request (\S+)!([0-9]+)`)
+
+// mergeRequestFromMessage returns the IID of the merge request that GitLab
+// mentions in the message of merge commits, if it belongs to the given project.
+func mergeRequestFromMessage(owner string, name string, message string) int {
+	match := mergeRequestReferenceRegex.FindStringSubmatch(message)
+	if match == nil || !strings.EqualFold(match[1], owner+"/"+name) {
+		return 0
+	}
+
+	iid, err := strconv.Atoi(match[2])
+	if err != nil {
+		return 0
+	}
+
+	return iid
+}
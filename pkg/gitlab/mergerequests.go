@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type mergeRequest struct {
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	TargetBranch string     `json:"target_branch"`
+	SourceBranch string     `json:"source_branch"`
+	WebURL       string     `json:"web_url"`
+	Labels       []string   `json:"labels"`
+	MergedAt     *time.Time `json:"merged_at"`
+	Author       user       `json:"author"`
+	MergeUser    *user      `json:"merge_user"`
+	Milestone    *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+}
+
+type user struct {
+	Username string `json:"username"`
+}
+
+// gitLabBotRegex matches the users GitLab creates for project and group
+// access tokens.
+var gitLabBotRegex = regexp.MustCompile(`^(project|group)_[0-9]+_bot(_[0-9a-f]+)?$`)
+
+func (u user) authorType() types.AuthorType {
+	if gitLabBotRegex.MatchString(u.Username) {
+		return types.AuthorTypeBot
+	}
+
+	return types.GuessAuthorType(u.Username)
+}
+
+func (c *Client) FetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error) {
+	result := map[int]types.PullRequest{}
+
+	for len(numbers) > 0 {
+		size := min(len(numbers), perPage)
+		chunk := numbers[:size]
+
+		query := url.Values{}
+		query.Set("state", "all")
+		query.Set("per_page", strconv.Itoa(perPage))
+		for _, number := range chunk {
+			query.Add("iids[]", strconv.Itoa(number))
+		}
+
+		c.log.WithField("mrs", len(chunk)).Debug("fetchMergeRequests()")
+
+		var mergeRequests []mergeRequest
+		if _, err := c.get(ctx, projectPath(owner, name)+"/merge_requests", query, &mergeRequests); err != nil {
+			return nil, fmt.Errorf("failed to fetch merge requests: %w", err)
+		}
+
+		for _, mr := range mergeRequests {
+			result[mr.IID] = convertMergeRequest(mr)
+		}
+
+		numbers = numbers[size:]
+	}
+
+	return result, nil
+}
+
+// fetchCommitMergeRequest returns the merged merge request that introduced
+// the commit, or nil if there is none. If there are several (e.g. because the
+// commit has been cherry-picked), the one targeting the branch is preferred.
+func (c *Client) fetchCommitMergeRequest(ctx context.Context, owner string, name string, hash string, branch string) (*types.PullRequest, error) {
+	var mergeRequests []mergeRequest
+
+	c.log.WithField("commit", hash).Debug("fetchCommitMergeRequest()")
+
+	if _, err := c.get(ctx, projectPath(owner, name)+"/repository/commits/"+hash+"/merge_requests", url.Values{}, &mergeRequests); err != nil {
+		return nil, fmt.Errorf("failed to fetch merge requests for commit %s: %w", hash, err)
+	}
+
+	var found *mergeRequest
+
+	for i, mr := range mergeRequests {
+		if mr.State != "merged" {
+			continue
+		}
+
+		if found == nil || (found.TargetBranch != branch && mr.TargetBranch == branch) {
+			found = &mergeRequests[i]
+		}
+	}
+
+	if found == nil {
+		return nil, nil
+	}
+
+	if len(mergeRequests) > 1 {
+		c.log.WithFields(logrus.Fields{
+			"commit": hash,
+			"branch": branch,
+			"mr":     found.IID,
+		}).Debug("Commit has multiple associated merge requests.")
+	}
+
+	pr := convertMergeRequest(*found)
+
+	return &pr, nil
+}
+
+func convertMergeRequest(api mergeRequest) types.PullRequest {
+	pr := types.PullRequest{
+		Number:     api.IID,
+		Title:      api.Title,
+		Body:       api.Description,
+		Author:     api.Author.Username,
+		AuthorType: api.Author.authorType(),
+		URL:        api.WebURL,
+		Labels:     sets.List(sets.New(api.Labels...)),
+		MergedAt:   api.MergedAt,
+		BaseBranch: api.TargetBranch,
+		HeadBranch: api.SourceBranch,
+	}
+
+	if api.MergeUser != nil {
+		pr.MergedBy = api.MergeUser.Username
+	}
+
+	if api.Milestone != nil {
+		pr.Milestone = api.Milestone.Title
+	}
+
+	return pr
+}
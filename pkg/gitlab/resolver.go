@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+type project struct {
+	DefaultBranch string `json:"default_branch"`
+}
+
+// ref is the common subset of branches and tags.
+type ref struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+func (c *Client) References(ctx context.Context, owner string, name string) (types.RepositoryRefs, error) {
+	result := types.RepositoryRefs{}
+
+	var p project
+	if _, err := c.get(ctx, projectPath(owner, name), url.Values{}, &p); err != nil {
+		return result, fmt.Errorf("failed to fetch project: %w", err)
+	}
+
+	result.DefaultBranch = p.DefaultBranch
+
+	branches, err := c.fetchRefs(ctx, projectPath(owner, name)+"/repository/branches")
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch branches: %w", err)
+	}
+
+	tags, err := c.fetchRefs(ctx, projectPath(owner, name)+"/repository/tags")
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	result.Branches = branches
+	result.Tags = tags
+
+	return result, nil
+}
+
+func (c *Client) fetchRefs(ctx context.Context, path string) ([]types.Ref, error) {
+	result := []types.Ref{}
+	page := "1"
+
+	for page != "" {
+		query := url.Values{}
+		query.Set("per_page", strconv.Itoa(perPage))
+		query.Set("page", page)
+
+		var (
+			err  error
+			refs []ref
+		)
+
+		page, err = c.get(ctx, path, query, &refs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range refs {
+			result = append(result, types.Ref{
+				Name: r.Name,
+				Hash: r.Commit.ID,
+			})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parallel runs API requests concurrently.
+package parallel
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the default number of requests that are run at the same time.
+const DefaultConcurrency = 4
+
+// ForEachChunk splits the items into chunks of the given size and calls fn
+// for each of them, with up to concurrency calls running at the same time.
+// The first error cancels all remaining calls and is returned.
+func ForEachChunk[T any](ctx context.Context, concurrency int, items []T, chunkSize int, fn func(ctx context.Context, chunk []T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	workers := make(chan struct{}, max(concurrency, 1))
+
+	for len(items) > 0 && ctx.Err() == nil {
+		size := min(len(items), chunkSize)
+		chunk := items[:size]
+		items = items[size:]
+
+		select {
+		case <-ctx.Done():
+			continue
+		case workers <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-workers }()
+
+			if err := fn(ctx, chunk); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// ForEach calls fn for every item, with up to concurrency calls running at
+// the same time. The first error cancels all remaining calls and is returned.
+func ForEach[T any](ctx context.Context, concurrency int, items []T, fn func(ctx context.Context, item T) error) error {
+	return ForEachChunk(ctx, concurrency, items, 1, func(ctx context.Context, chunk []T) error {
+		return fn(ctx, chunk[0])
+	})
+}
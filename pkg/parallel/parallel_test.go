@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachChunk(t *testing.T) {
+	items := make([]int, 95)
+	for i := range items {
+		items[i] = i
+	}
+
+	var (
+		lock    sync.Mutex
+		seen    = map[int]bool{}
+		running atomic.Int32
+		peak    atomic.Int32
+	)
+
+	err := ForEachChunk(context.Background(), 3, items, 10, func(_ context.Context, chunk []int) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, item := range chunk {
+			seen[item] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(seen) != len(items) {
+		t.Errorf("Expected all %d items to be processed, got %d.", len(items), len(seen))
+	}
+
+	if p := peak.Load(); p > 3 || p < 2 {
+		t.Errorf("Expected up to 3 concurrent calls, got %d.", p)
+	}
+}
+
+func TestForEachChunkStopsOnError(t *testing.T) {
+	items := make([]int, 100)
+
+	var calls atomic.Int32
+
+	expected := errors.New("boom")
+
+	err := ForEachChunk(context.Background(), 1, items, 10, func(_ context.Context, chunk []int) error {
+		if calls.Add(1) == 2 {
+			return expected
+		}
+
+		return nil
+	})
+	if !errors.Is(err, expected) {
+		t.Fatalf("Expected %v, got %v", expected, err)
+	}
+
+	if n := calls.Load(); n != 2 {
+		t.Errorf("Expected processing to stop after the error, got %d calls.", n)
+	}
+}
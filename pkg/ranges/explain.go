@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ranges
+
+import (
+	"k8c.io/gchl/pkg/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Explanation describes how a range has been resolved and which commits it
+// contains, to troubleshoot unexpected changelogs.
+type Explanation struct {
+	Head                string            `json:"head"`
+	HeadRef             string            `json:"headRef,omitempty"`
+	Branch              string            `json:"branch,omitempty"`
+	PreviousRelease     string            `json:"previousRelease,omitempty"`
+	PreviousReleaseHash string            `json:"previousReleaseHash,omitempty"`
+	Boundary            *types.Boundary   `json:"boundary,omitempty"`
+	Commits             []ExplainedCommit `json:"commits"`
+}
+
+type ExplainedCommit struct {
+	Hash        string `json:"hash"`
+	Title       string `json:"title"`
+	PullRequest int    `json:"pullRequest,omitempty"`
+	// Included is false for commits that have been filtered out of the
+	// changelog, e.g. commits without a pull request.
+	Included bool `json:"included"`
+}
+
+// Explain describes the range and the commits of its history. The history
+// should include direct commits, so that they can be listed as well. The
+// included commits are those that remain for the changelog after filtering
+// the history.
+func Explain(rng types.Range, commits []types.Commit, included []types.Commit) Explanation {
+	explanation := Explanation{
+		Head:                rng.Head,
+		HeadRef:             rng.HeadRef,
+		Branch:              rng.Branch,
+		PreviousRelease:     rng.PreviousRelease,
+		PreviousReleaseHash: rng.PreviousReleaseHash,
+		Commits:             []ExplainedCommit{},
+	}
+
+	if rng.Boundary != nil && rng.Boundary.Hash != "" {
+		explanation.Boundary = rng.Boundary
+	}
+
+	includedHashes := sets.New[string]()
+	for _, commit := range included {
+		includedHashes.Insert(commit.Hash)
+	}
+
+	for _, commit := range commits {
+		explanation.Commits = append(explanation.Commits, ExplainedCommit{
+			Hash:        commit.Hash,
+			Title:       commit.Title,
+			PullRequest: commit.PullRequest.Number,
+			Included:    includedHashes.Has(commit.Hash),
+		})
+	}
+
+	return explanation
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ranges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// logChunkSize is the number of commits fetched at once while looking for
+	// the fork point.
+	logChunkSize = 250
+
+	// maxForkPointDistance is the maximum number of commits that are walked
+	// on each side before giving up on finding the fork point.
+	maxForkPointDistance = 10000
+)
+
+// previousReleaseCommits returns the commits of the previous release, from its
+// head back to (and including) the fork point where it meets the history of
+// head. The fork point is determined using the merge base if the source
+// supports it, otherwise both histories are walked until they meet.
+func previousReleaseCommits(ctx context.Context, client source.Source, log logrus.FieldLogger, opts *types.Options, head string, prevHead string) (sets.Set[string], error) {
+	forkPoint := ""
+
+	if mergeBaser, ok := client.(source.MergeBaser); ok {
+		base, err := mergeBaser.MergeBase(ctx, opts.Organization, opts.Repository, prevHead, head)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine merge base with previous release: %w", err)
+		}
+
+		forkPoint = base
+	}
+
+	prevWalker := newLogWalker(client, opts, prevHead)
+	headWalker := newLogWalker(client, opts, head)
+
+	var prevCommits, headCommits []types.Commit
+
+	for walked := 0; ; walked += logChunkSize {
+		if walked >= maxForkPointDistance {
+			return nil, fmt.Errorf("could not find the fork point with the previous release within %d commits", maxForkPointDistance)
+		}
+
+		chunk, err := prevWalker.next(ctx, logChunkSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch commits from previous release branch: %w", err)
+		}
+
+		prevCommits = append(prevCommits, chunk...)
+
+		if forkPoint == "" {
+			chunk, err := headWalker.next(ctx, logChunkSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch commits from release branch: %w", err)
+			}
+
+			headCommits = append(headCommits, chunk...)
+
+			forkPoint = firstCommonCommit(headCommits, toLookupTable(prevCommits))
+
+			if forkPoint == "" {
+				if prevWalker.exhausted && headWalker.exhausted {
+					return nil, errors.New("the previous release does not share any history with this release")
+				}
+
+				continue
+			}
+		}
+
+		idx := slices.IndexFunc(prevCommits, func(c types.Commit) bool {
+			return c.Hash == forkPoint
+		})
+
+		switch {
+		case idx >= 0:
+			prevCommits = prevCommits[:idx+1]
+		case !prevWalker.exhausted:
+			continue
+		default:
+			// The fork point is not part of the previous release's first-parent
+			// history (e.g. because the previous release branch has been merged
+			// back), so we need to rely on the entire history.
+			log.WithField("forkPoint", forkPoint).Warn("Fork point is not part of the previous release history.")
+		}
+
+		log.WithFields(logrus.Fields{
+			"forkPoint": forkPoint,
+			"commits":   len(prevCommits),
+		}).Info("Found fork point with previous release.")
+
+		result := toLookupTable(prevCommits)
+		result.Insert(forkPoint)
+
+		return result, nil
+	}
+}
+
+// logWalker fetches the first-parent history of a commit in chunks. Each chunk
+// continues the log at the last commit of the previous one instead of fetching
+// the history from the head again.
+type logWalker struct {
+	client    source.Source
+	opts      *types.Options
+	head      string
+	last      string
+	exhausted bool
+}
+
+func newLogWalker(client source.Source, opts *types.Options, head string) *logWalker {
+	return &logWalker{
+		client: client,
+		opts:   opts,
+		head:   head,
+	}
+}
+
+// next returns up to n further commits, or none once the history has ended.
+func (w *logWalker) next(ctx context.Context, n int) ([]types.Commit, error) {
+	if w.exhausted {
+		return nil, nil
+	}
+
+	start, limit := w.head, n
+	if w.last != "" {
+		// the log begins with the last commit we already know
+		start, limit = w.last, n+1
+	}
+
+	commits, err := w.client.Log(ctx, w.opts.Organization, w.opts.Repository, start, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	w.exhausted = len(commits) < limit
+
+	if w.last != "" && len(commits) > 0 {
+		commits = commits[1:]
+	}
+
+	if len(commits) > 0 {
+		w.last = commits[len(commits)-1].Hash
+	}
+
+	return commits, nil
+}
+
+// firstCommonCommit returns the hash of the first commit that is contained
+// in the given set, or an empty string.
+func firstCommonCommit(commits []types.Commit, hashes sets.Set[string]) string {
+	for _, commit := range commits {
+		if hashes.Has(commit.Hash) {
+			return commit.Hash
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ranges
+
+import (
+	"k8c.io/gchl/pkg/types"
+)
+
+// PreRelease is a pre-release and the commits that first appeared in it.
+type PreRelease struct {
+	Tag     types.Ref
+	Commits []types.Commit
+}
+
+// PreReleaseTracker records which pre-release each commit of a range first
+// appeared in. It learns about every commit while the history is walked, so
+// that a pre-release is not lost if its tag sits on a commit that is filtered
+// out later (e.g. a direct commit).
+type PreReleaseTracker struct {
+	tags map[string]types.Ref
+	// found are the pre-releases in the order their tags were walked past,
+	// i.e. newest first.
+	found []types.Ref
+	// members maps commit hashes to the index of their pre-release in found.
+	members map[string]int
+}
+
+// TrackPreReleases wraps the stopper of the range, so that the returned
+// tracker sees every commit that is part of the range. It returns nil if the
+// range has no pre-releases.
+func TrackPreReleases(rng *types.Range) *PreReleaseTracker {
+	if len(rng.PreReleases) == 0 {
+		return nil
+	}
+
+	tracker := &PreReleaseTracker{
+		tags:    map[string]types.Ref{},
+		members: map[string]int{},
+	}
+
+	for _, tag := range rng.PreReleases {
+		tracker.tags[tag.Hash] = tag
+	}
+
+	stop := rng.Stop
+	rng.Stop = func(c types.Commit) (bool, error) {
+		stopped, err := stop(c)
+		if err != nil || stopped {
+			return stopped, err
+		}
+
+		tracker.observe(c.Hash)
+
+		return false, nil
+	}
+
+	return tracker
+}
+
+func (t *PreReleaseTracker) observe(hash string) {
+	if tag, ok := t.tags[hash]; ok {
+		t.found = append(t.found, tag)
+	}
+
+	if len(t.found) > 0 {
+		t.members[hash] = len(t.found) - 1
+	}
+}
+
+// SplitByPreReleases splits the (filtered) commits of a range, newest first,
+// into the commits that are newer than all pre-releases and the commits per
+// pre-release, newest first. Every pre-release whose tag was part of the range
+// is returned, even if none of its commits remain. A nil tracker returns all
+// commits unchanged.
+func SplitByPreReleases(commits []types.Commit, tracker *PreReleaseTracker) ([]types.Commit, []PreRelease) {
+	if tracker == nil {
+		return commits, nil
+	}
+
+	final := []types.Commit{}
+	groups := make([]PreRelease, len(tracker.found))
+
+	for i, tag := range tracker.found {
+		groups[i].Tag = tag
+	}
+
+	for _, commit := range commits {
+		if i, ok := tracker.members[commit.Hash]; ok {
+			groups[i].Commits = append(groups[i].Commits, commit)
+		} else {
+			final = append(final, commit)
+		}
+	}
+
+	return final, groups
+}
@@ -18,12 +18,12 @@ package ranges
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
+	"slices"
 	"strings"
 
-	"k8c.io/gchl/pkg/github"
+	"k8c.io/gchl/pkg/source"
 	"k8c.io/gchl/pkg/types"
 
 	"github.com/Masterminds/semver/v3"
@@ -31,28 +31,44 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
-var releaseBranchRegex = regexp.MustCompile(`^release/v([0-9]+)\.([0-9]+)$`)
+func DetermineRange(ctx context.Context, client source.Source, log logrus.FieldLogger, opts *types.Options) (types.Range, error) {
+	allRepoRefs, err := client.References(ctx, opts.Organization, opts.Repository)
+	if err != nil {
+		return types.Range{}, fmt.Errorf("failed to fetch references: %w", err)
+	}
 
-func DetermineRange(ctx context.Context, client *github.Client, log logrus.FieldLogger, opts *types.Options) (string, github.Stopper, error) {
-	targetVersion := opts.ForVersion
+	sv, err := semver.NewVersion(opts.ForVersion)
+	if err != nil {
+		return types.Range{}, fmt.Errorf("failed to parse version %q: %w", opts.ForVersion, err)
+	}
 
-	allRepoRefs, err := client.References(ctx, opts.Organization, opts.Repository)
+	naming, err := opts.NamingScheme()
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to fetch references: %w", err)
+		return types.Range{}, err
 	}
 
 	// check if the target version exists as a tag in the repo
 	var targetTag *types.Ref
 	for i, tag := range allRepoRefs.Tags {
-		if tag.Name == targetVersion {
+		if tagVersion, err := naming.ParseTag(tag.Name); err == nil && tagVersion.Equal(sv) {
 			targetTag = &allRepoRefs.Tags[i]
 			break
 		}
 	}
 
-	sv, err := semver.NewVersion(opts.ForVersion)
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to parse version %q: %w", opts.ForVersion, err)
+	// When grouping the changes of a final release by its pre-releases, the
+	// pre-release tags mark where each group begins.
+	var preReleases []types.Ref
+	if opts.PreReleaseMode == types.PreReleasesGrouped && sv.Prerelease() == "" {
+		preReleases = findPreReleaseTags(allRepoRefs.Tags, sv, naming)
+	}
+
+	// The branch the release happens on; this is the release branch if it
+	// exists already, otherwise the primary branch.
+	releaseBranch := naming.Branch(sv.Major(), sv.Minor())
+	historyBranch := releaseBranch
+	if !hasBranch(allRepoRefs, releaseBranch) {
+		historyBranch = allRepoRefs.DefaultBranch
 	}
 
 	if targetTag != nil {
@@ -63,7 +79,6 @@ func DetermineRange(ctx context.Context, client *github.Client, log logrus.Field
 		// releases, there might not be a release branch yet, so we will fallback to the
 		// primary branch.
 
-		releaseBranch := fmt.Sprintf("release/v%d.%d", sv.Major(), sv.Minor())
 		for i, branch := range allRepoRefs.Branches {
 			if branch.Name == releaseBranch {
 				targetTag = &allRepoRefs.Branches[i]
@@ -84,7 +99,7 @@ func DetermineRange(ctx context.Context, client *github.Client, log logrus.Field
 			}
 
 			if targetTag == nil {
-				return "", nil, fmt.Errorf("no commit details exist for primary branch %q", allRepoRefs.DefaultBranch)
+				return types.Range{}, fmt.Errorf("no commit details exist for primary branch %q", allRepoRefs.DefaultBranch)
 			}
 		}
 	}
@@ -93,11 +108,34 @@ func DetermineRange(ctx context.Context, client *github.Client, log logrus.Field
 	// the commit that is tagged with opts.ForVersion). Now we need to figure
 	// out far back we need to go to collect all relevant commits.
 
-	// If a custom --end flag is given, this is trivial.
+	// If a custom --start or --end flag is given, this is trivial.
+
+	boundary := &types.Boundary{}
+
+	if opts.Start != "" {
+		rng, err := startRange(ctx, client, log, opts, allRepoRefs, targetTag.Hash, historyBranch, boundary)
+		rng.PreReleases = preReleases
+		rng.HeadRef = targetTag.Name
+
+		return rng, err
+	}
 
 	if opts.End != "" {
-		return targetTag.Hash, func(c types.Commit) bool {
-			return strings.HasPrefix(c.Hash, opts.End)
+		return types.Range{
+			Head:   targetTag.Hash,
+			Branch: historyBranch,
+			Stop: func(c types.Commit) (bool, error) {
+				if strings.HasPrefix(c.Hash, opts.End) {
+					boundary.Record(c.Hash, types.StopReasonEnd, opts.End)
+					return true, nil
+				}
+
+				return false, nil
+			},
+			IncludeDirectCommits: opts.IncludeDirectCommits,
+			PreReleases:          preReleases,
+			HeadRef:              targetTag.Name,
+			Boundary:             boundary,
 		}, nil
 	}
 
@@ -124,37 +162,111 @@ func DetermineRange(ctx context.Context, client *github.Client, log logrus.Field
 	//      we only care about the point where the old and new release branches meet.
 	//
 	// To achieve (b), we need a list of commits that belong to the previous release
-	// branch.
+	// branch. Repositories without release branches can use the commit tagged for
+	// the previous published release instead.
 
-	// go back one minor release, handle underflows (do not go from v2.0 to v1.-1)
-	prevMajor := int(sv.Major())
-	prevMinor := int(sv.Minor()) - 1
-	if prevMinor < 0 {
-		prevMajor--
+	prevRelease, prevReleaseHead, err := findPreviousReleaseHead(ctx, client, log, opts, naming, sv, allRepoRefs)
+	if err != nil {
+		return types.Range{}, err
+	}
 
-		// find the most recent (highest) minor release for the given major
-		for _, branch := range allRepoRefs.Branches {
-			match := releaseBranchRegex.FindStringSubmatch(branch.Name)
-			if match != nil {
-				minor, err := strconv.Atoi(match[2])
-				if err == nil {
-					major, err := strconv.Atoi(match[1])
-
-					if err == nil && major == prevMajor && minor > prevMinor {
-						prevMinor = minor
-					}
-				}
+	log.Info("Fetching previous release commits…")
+
+	previousHashes, err := previousReleaseCommits(ctx, client, log, opts, targetTag.Hash, prevReleaseHead)
+	if err != nil {
+		return types.Range{}, err
+	}
+
+	tags := toTagLookupTable(allRepoRefs.Tags, sv, naming)
+
+	stop := func(c types.Commit) (bool, error) {
+		// We found another tag
+		if tags.Has(c.Hash) {
+			boundary.Record(c.Hash, types.StopReasonTag, tagName(allRepoRefs.Tags, c.Hash))
+			return true, nil
+		}
+
+		// We found the intersection between the previous release branch and
+		// the current release branch.
+		if previousHashes.Has(c.Hash) {
+			boundary.Record(c.Hash, types.StopReasonPreviousRelease, prevRelease)
+			return true, nil
+		}
+
+		return false, nil
+	}
+
+	return types.Range{
+		Head:                 targetTag.Hash,
+		Branch:               historyBranch,
+		Stop:                 stop,
+		IncludeDirectCommits: opts.IncludeDirectCommits,
+		PreReleases:          preReleases,
+		HeadRef:              targetTag.Name,
+		PreviousRelease:      prevRelease,
+		PreviousReleaseHash:  prevReleaseHead,
+		Boundary:             boundary,
+	}, nil
+}
+
+// tagName returns the name of a tag pointing to the commit.
+func tagName(tags []types.Ref, hash string) string {
+	for _, tag := range tags {
+		if tag.Hash == hash {
+			return tag.Name
+		}
+	}
+
+	return ""
+}
+
+// findPreviousReleaseHead returns the name and newest commit of the previous
+// release, which is either the head of the previous release branch or the
+// commit tagged for the previous published release or version, depending on
+// the strategy.
+func findPreviousReleaseHead(ctx context.Context, client source.Source, log logrus.FieldLogger, opts *types.Options, naming *types.NamingScheme, sv *semver.Version, allRepoRefs types.RepositoryRefs) (string, string, error) {
+	if opts.PreviousVersionStrategy == types.PreviousVersionFromTags {
+		prevTag, err := findPreviousTag(allRepoRefs.Tags, naming, sv)
+		if err != nil {
+			return "", "", err
+		}
+
+		log.WithField("previous", prevTag.Name).Info("Detected previous tag.")
+
+		return prevTag.Name, prevTag.Hash, nil
+	}
+
+	if opts.PreviousVersionStrategy == types.PreviousVersionFromReleases {
+		releases, ok := client.(source.ReleaseSource)
+		if !ok {
+			return "", "", errors.New("the source does not support releases")
+		}
+
+		prevRelease, err := findPreviousRelease(ctx, releases, opts, naming, sv)
+		if err != nil {
+			return "", "", err
+		}
+
+		hash := prevRelease.Hash
+		for _, tag := range allRepoRefs.Tags {
+			if tag.Name == prevRelease.Tag {
+				hash = tag.Hash
+				break
 			}
 		}
 
-		if prevMinor < 0 {
-			return "", nil, fmt.Errorf("could not find a release branch for any minor in the v%d release", prevMajor)
+		if hash == "" {
+			return "", "", fmt.Errorf("could not find commit for release tag %q", prevRelease.Tag)
 		}
+
+		log.WithField("previous", prevRelease.Tag).Info("Detected previous release.")
+
+		return prevRelease.Tag, hash, nil
 	}
 
-	prevReleaseBranch, err := findPreviousReleaseBranch(sv, allRepoRefs)
+	prevReleaseBranch, err := findPreviousReleaseBranch(sv, allRepoRefs, naming)
 	if err != nil {
-		return "", nil, err
+		return "", "", err
 	}
 
 	// determine the HEAD of this previous release branch
@@ -167,39 +279,157 @@ func DetermineRange(ctx context.Context, client *github.Client, log logrus.Field
 	}
 
 	if prevReleaseHead == "" {
-		return "", nil, fmt.Errorf("could not find HEAD for release branch %q", prevReleaseBranch)
+		return "", "", fmt.Errorf("could not find HEAD for release branch %q", prevReleaseBranch)
 	}
 
 	log.WithField("previous", prevReleaseBranch).Info("Detected previous release branch.")
 
-	// 250 commits should be way more than enough (250 commits are only the number of patches since the
-	// release was done, not everything that went into the release).
-	log.Info("Fetching previous release commits…")
-	previousReleaseCommits, err := client.Log(ctx, opts.Organization, opts.Repository, prevReleaseHead, 250)
+	return prevReleaseBranch, prevReleaseHead, nil
+}
+
+// findPreviousRelease returns the published, stable release with the highest
+// version below the current version.
+func findPreviousRelease(ctx context.Context, client source.ReleaseSource, opts *types.Options, naming *types.NamingScheme, currentVersion *semver.Version) (*types.Release, error) {
+	releases, err := client.Releases(ctx, opts.Organization, opts.Repository)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to fetch commits from previous release branch: %w", err)
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
 	}
 
-	previousReleaseCommitHashes := toLookupTable(previousReleaseCommits)
-	tags := toTagLookupTable(allRepoRefs.Tags, sv)
+	var (
+		prevRelease *types.Release
+		prevVersion *semver.Version
+	)
 
-	return targetTag.Hash, func(c types.Commit) bool {
-		// We found the intersection between the previous release branch and
-		// the current release branch.
-		if previousReleaseCommitHashes.Has(c.Hash) {
-			return true
+	for i, release := range releases {
+		if release.Draft || release.Prerelease {
+			continue
 		}
 
-		// We found another tag
-		if tags.Has(c.Hash) {
-			return true
+		version, err := naming.ParseTag(release.Tag)
+		if err != nil || version.Prerelease() != "" || !version.LessThan(currentVersion) {
+			continue
+		}
+
+		if prevVersion == nil || version.GreaterThan(prevVersion) {
+			prevRelease = &releases[i]
+			prevVersion = version
+		}
+	}
+
+	if prevRelease == nil {
+		return nil, fmt.Errorf("could not find a published release before v%s", currentVersion)
+	}
+
+	return prevRelease, nil
+}
+
+// findPreviousTag returns the stable tag with the highest version below the
+// current version. Only tags following the naming scheme are considered, so
+// in component mode, only the tags of the same component are found.
+func findPreviousTag(tags []types.Ref, naming *types.NamingScheme, currentVersion *semver.Version) (*types.Ref, error) {
+	var (
+		prevTag     *types.Ref
+		prevVersion *semver.Version
+	)
+
+	for i, tag := range tags {
+		version, err := naming.ParseTag(tag.Name)
+		if err != nil || version.Prerelease() != "" || !version.LessThan(currentVersion) {
+			continue
+		}
+
+		if prevVersion == nil || version.GreaterThan(prevVersion) {
+			prevTag = &tags[i]
+			prevVersion = version
 		}
+	}
+
+	if prevTag == nil {
+		return nil, fmt.Errorf("could not find a tag before %s", naming.Tag(currentVersion))
+	}
+
+	return prevTag, nil
+}
+
+// maxMergeBaseLog is the number of commits before the merge base of --start
+// that are used to detect if the walk has missed the merge base.
+const maxMergeBaseLog = 500
+
+// startRange returns the range from the head back to the merge base of the
+// head and the --start ref.
+func startRange(ctx context.Context, client source.Source, log logrus.FieldLogger, opts *types.Options, allRepoRefs types.RepositoryRefs, head string, branch string, boundary *types.Boundary) (types.Range, error) {
+	mergeBaser, ok := client.(source.MergeBaser)
+	if !ok {
+		return types.Range{}, errors.New("the source does not support --start")
+	}
+
+	start := resolveRef(allRepoRefs, opts.Start)
+
+	base, err := mergeBaser.MergeBase(ctx, opts.Organization, opts.Repository, start, head)
+	if err != nil {
+		return types.Range{}, fmt.Errorf("failed to determine merge base: %w", err)
+	}
+
+	log.WithFields(logrus.Fields{
+		"start":     opts.Start,
+		"mergeBase": base,
+	}).Info("Resolved start to its merge base with the head.")
+
+	// The walk only follows the first parents of the head, so it misses the
+	// merge base if that has been merged in from another branch. Reaching any
+	// of the commits before the merge base means it has been missed.
+	baseCommits, err := client.Log(ctx, opts.Organization, opts.Repository, base, maxMergeBaseLog)
+	if err != nil {
+		return types.Range{}, fmt.Errorf("failed to fetch commits before merge base: %w", err)
+	}
 
-		return false
+	beforeBase := toLookupTable(baseCommits)
+
+	return types.Range{
+		Head:   head,
+		Branch: branch,
+		Stop: func(c types.Commit) (bool, error) {
+			if c.Hash == base {
+				boundary.Record(c.Hash, types.StopReasonStart, opts.Start)
+				return true, nil
+			}
+
+			if beforeBase.Has(c.Hash) {
+				return false, fmt.Errorf("merge base %s of %q and the head is not part of the first-parent history of the head (reached the older commit %s instead), use --end to choose a commit on that history", base, opts.Start, c.Hash)
+			}
+
+			return false, nil
+		},
+		IncludeDirectCommits: opts.IncludeDirectCommits,
+		Boundary:             boundary,
 	}, nil
 }
 
-func findPreviousReleaseBranch(currentVersion *semver.Version, allRepoRefs types.RepositoryRefs) (string, error) {
+// resolveRef returns the hash for a tag or branch name. Anything else is
+// assumed to be a commit hash already.
+func resolveRef(allRepoRefs types.RepositoryRefs, ref string) string {
+	for _, refs := range [][]types.Ref{allRepoRefs.Tags, allRepoRefs.Branches} {
+		for _, r := range refs {
+			if r.Name == ref {
+				return r.Hash
+			}
+		}
+	}
+
+	return ref
+}
+
+func hasBranch(allRepoRefs types.RepositoryRefs, name string) bool {
+	for _, branch := range allRepoRefs.Branches {
+		if branch.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findPreviousReleaseBranch(currentVersion *semver.Version, allRepoRefs types.RepositoryRefs, naming *types.NamingScheme) (string, error) {
 	// go back one minor release, handle underflows (do not go from v2.0 to v1.-1)
 	prevMajor := int(currentVersion.Major())
 	prevMinor := int(currentVersion.Minor()) - 1
@@ -208,16 +438,9 @@ func findPreviousReleaseBranch(currentVersion *semver.Version, allRepoRefs types
 
 		// find the most recent (highest) minor release for the given major
 		for _, branch := range allRepoRefs.Branches {
-			match := releaseBranchRegex.FindStringSubmatch(branch.Name)
-			if match != nil {
-				minor, err := strconv.Atoi(match[2])
-				if err == nil {
-					major, err := strconv.Atoi(match[1])
-
-					if err == nil && major == prevMajor && minor > prevMinor {
-						prevMinor = minor
-					}
-				}
+			major, minor, ok := naming.ParseBranch(branch.Name)
+			if ok && int(major) == prevMajor && int(minor) > prevMinor {
+				prevMinor = int(minor)
 			}
 		}
 
@@ -226,7 +449,7 @@ func findPreviousReleaseBranch(currentVersion *semver.Version, allRepoRefs types
 		}
 	}
 
-	return fmt.Sprintf("release/v%d.%d", prevMajor, prevMinor), nil
+	return naming.Branch(uint64(prevMajor), uint64(prevMinor)), nil
 }
 
 func toLookupTable(commits []types.Commit) sets.Set[string] {
@@ -242,14 +465,48 @@ func toLookupTable(commits []types.Commit) sets.Set[string] {
 // so that the lookup only contains stable versions. This is because later when we use it,
 // we do not want to stop at alpha versions, but only on stable versions. The same goes for
 // the start version (otherwise we would stop immediately on the first commit).
-func toTagLookupTable(tags []types.Ref, targetVersion *semver.Version) sets.Set[string] {
+// The exception are pre-releases themselves: the changelog for v1.2.0-rc.2 should only
+// contain the changes since v1.2.0-rc.1, so earlier pre-releases of the same version
+// are included.
+func toTagLookupTable(tags []types.Ref, targetVersion *semver.Version, naming *types.NamingScheme) sets.Set[string] {
 	result := sets.New[string]()
 	for _, tag := range tags {
-		sv, err := semver.NewVersion(tag.Name)
-		if err == nil && sv.Prerelease() == "" && !targetVersion.Equal(sv) {
+		sv, err := naming.ParseTag(tag.Name)
+		if err != nil || targetVersion.Equal(sv) {
+			continue
+		}
+
+		if sv.Prerelease() == "" || (targetVersion.Prerelease() != "" && sameCoreVersion(sv, targetVersion) && sv.LessThan(targetVersion)) {
 			result.Insert(tag.Hash)
 		}
 	}
 
 	return result
 }
+
+// findPreReleaseTags returns the tags of all pre-releases of the given
+// version, newest first.
+func findPreReleaseTags(tags []types.Ref, version *semver.Version, naming *types.NamingScheme) []types.Ref {
+	versions := map[string]*semver.Version{}
+	result := []types.Ref{}
+
+	for _, tag := range tags {
+		sv, err := naming.ParseTag(tag.Name)
+		if err == nil && sv.Prerelease() != "" && sameCoreVersion(sv, version) {
+			versions[tag.Name] = sv
+			result = append(result, tag)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b types.Ref) int {
+		return versions[b.Name].Compare(versions[a.Name])
+	})
+
+	return result
+}
+
+// sameCoreVersion returns true if both versions only differ in their
+// pre-release and metadata, e.g. v1.2.0-rc.1 and v1.2.0.
+func sameCoreVersion(a, b *semver.Version) bool {
+	return a.Major() == b.Major() && a.Minor() == b.Minor() && a.Patch() == b.Patch()
+}
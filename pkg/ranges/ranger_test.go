@@ -0,0 +1,699 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ranges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+// chainRepository is a memory repository that is built from chains of
+// commits, each of them merged via its own pull request.
+type chainRepository struct {
+	*source.Memory
+	number int
+}
+
+func newChainRepository() *chainRepository {
+	return &chainRepository{Memory: source.NewMemory("main")}
+}
+
+// addChain adds the commits on top of the parent, which is empty for root
+// commits. Each commit is the first parent of the next one.
+func (r *chainRepository) addChain(parent string, chain ...string) {
+	for _, hash := range chain {
+		r.number++
+
+		r.AddCommit(types.Commit{
+			Hash:  hash,
+			Title: "commit " + hash,
+			PullRequest: types.PullRequest{
+				Number: r.number,
+				Title:  "PR for " + hash,
+			},
+		}, parent)
+
+		parent = hash
+	}
+}
+
+// hashes returns the hashes of the commits, to compare them in tests.
+func hashes(commits []types.Commit) []string {
+	result := []string{}
+	for _, commit := range commits {
+		result = append(result, commit.Hash)
+	}
+
+	return result
+}
+
+// newTestRepository creates a repository with the following layout:
+//
+//	main:         m1 - m2 - m3 - m4 - m5 - m6
+//	release/v1.0: m1
+//	release/v1.1:           m3 - r1 - r2         (v1.1.0 is tagged and released on r1)
+//	release/v1.2:                     m5 - s1         (draft release v1.2.0 on s1)
+//
+// v1.2.0-rc.1 and v1.2.0-rc.2 are tagged on m4 and m5.
+func newTestRepository() *source.Memory {
+	repo := newChainRepository()
+	repo.addChain("", "m1", "m2", "m3", "m4", "m5", "m6")
+	repo.addChain("m3", "r1", "r2")
+	repo.addChain("m5", "s1")
+
+	repo.AddBranch("main", "m6")
+	repo.AddBranch("release/v1.0", "m1")
+	repo.AddBranch("release/v1.1", "r2")
+	repo.AddBranch("release/v1.2", "s1")
+	repo.AddTag("v1.1.0", "r1")
+	repo.AddTag("v1.2.0-rc.1", "m4")
+	repo.AddTag("v1.2.0-rc.2", "m5")
+
+	repo.AddRelease(types.Release{Tag: "v1.1.0", Hash: "r1"})
+	repo.AddRelease(types.Release{Tag: "v1.2.0", Hash: "s1", Draft: true})
+
+	return repo.Memory
+}
+
+func TestDetermineRange(t *testing.T) {
+	testcases := []struct {
+		name        string
+		opts        types.Options
+		branch      string
+		expected    []string
+		preReleases []string
+		invalid     bool
+	}{
+		{
+			name:     "new minor release without release branch uses the primary branch",
+			opts:     types.Options{ForVersion: "1.3.0"},
+			branch:   "main",
+			expected: []string{"m6"},
+		},
+		{
+			name:     "release branch stops at the previous release branch",
+			opts:     types.Options{ForVersion: "1.2.0"},
+			branch:   "release/v1.2",
+			expected: []string{"s1", "m5", "m4"},
+		},
+		{
+			name:     "release candidate stops at the previous candidate",
+			opts:     types.Options{ForVersion: "1.2.0-rc.2"},
+			branch:   "release/v1.2",
+			expected: []string{"m5"},
+		},
+		{
+			name:        "final release grouped by release candidates",
+			opts:        types.Options{ForVersion: "1.2.0", PreReleaseMode: types.PreReleasesGrouped},
+			branch:      "release/v1.2",
+			expected:    []string{"s1", "m5", "m4"},
+			preReleases: []string{"v1.2.0-rc.2", "v1.2.0-rc.1"},
+		},
+		{
+			name:     "patch release stops at the previous tag",
+			opts:     types.Options{ForVersion: "1.1.1"},
+			branch:   "release/v1.1",
+			expected: []string{"r2"},
+		},
+		{
+			name:     "new major release uses the latest minor of the previous major",
+			opts:     types.Options{ForVersion: "2.0.0"},
+			branch:   "main",
+			expected: []string{"m6"},
+		},
+		{
+			name:     "custom end commit",
+			opts:     types.Options{ForVersion: "1.3.0", End: "m4"},
+			branch:   "main",
+			expected: []string{"m6", "m5"},
+		},
+		{
+			name:     "previous published release",
+			opts:     types.Options{ForVersion: "1.3.0", PreviousVersionStrategy: types.PreviousVersionFromReleases},
+			branch:   "main",
+			expected: []string{"m6", "m5", "m4"},
+		},
+		{
+			name:    "no previous published release",
+			opts:    types.Options{ForVersion: "1.1.0", PreviousVersionStrategy: types.PreviousVersionFromReleases},
+			invalid: true,
+		},
+		{
+			name:     "custom start tag",
+			opts:     types.Options{ForVersion: "1.3.0", Start: "v1.1.0"},
+			branch:   "main",
+			expected: []string{"m6", "m5", "m4"},
+		},
+		{
+			name:     "custom start branch",
+			opts:     types.Options{ForVersion: "1.2.0", Start: "main"},
+			branch:   "release/v1.2",
+			expected: []string{"s1"},
+		},
+		{
+			name:    "no previous release branch",
+			opts:    types.Options{ForVersion: "1.0.0"},
+			invalid: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newTestRepository()
+			log := logrus.New()
+
+			rng, err := DetermineRange(ctx, repo, log, &testcase.opts)
+			if err != nil {
+				if !testcase.invalid {
+					t.Fatalf("Failed to determine range: %v", err)
+				}
+
+				return
+			}
+
+			if testcase.invalid {
+				t.Fatal("Expected an error, but got none.")
+			}
+
+			if rng.Branch != testcase.branch {
+				t.Errorf("Expected branch %q, got %q.", testcase.branch, rng.Branch)
+			}
+
+			preReleases := []string{}
+			for _, tag := range rng.PreReleases {
+				preReleases = append(preReleases, tag.Name)
+			}
+
+			if len(testcase.preReleases) > 0 && !slices.Equal(testcase.preReleases, preReleases) {
+				t.Errorf("Expected pre-releases %v, got %v.", testcase.preReleases, preReleases)
+			}
+
+			commits, err := repo.History(ctx, "", "", rng)
+			if err != nil {
+				t.Fatalf("Failed to fetch history: %v", err)
+			}
+
+			if !slices.Equal(testcase.expected, hashes(commits)) {
+				t.Fatalf("Expected commits %v, got %v.", testcase.expected, hashes(commits))
+			}
+		})
+	}
+}
+
+// failingLog is a repository whose commit log cannot be fetched.
+type failingLog struct {
+	*source.Memory
+}
+
+func (f failingLog) Log(_ context.Context, _ string, _ string, _ string, _ int) ([]types.Commit, error) {
+	return nil, errors.New("log is unavailable")
+}
+
+func TestDetermineRangeReportsLogErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := failingLog{Memory: newTestRepository()}
+
+	if _, err := DetermineRange(ctx, repo, logrus.New(), &types.Options{ForVersion: "1.2.0"}); err == nil {
+		t.Fatal("Expected the log error to be reported, but got none.")
+	}
+}
+
+// splitTestHistory walks the history c5 - c1 of the repository, with
+// pre-releases tagged on c3 and c1, and splits the result.
+func splitTestHistory(t *testing.T, repo source.Source) ([]types.Commit, []PreRelease) {
+	rng := types.Range{
+		Head: "c5",
+		Stop: func(c types.Commit) (bool, error) {
+			return false, nil
+		},
+		PreReleases: []types.Ref{{Name: "v1.0.0-rc.2", Hash: "c3"}, {Name: "v1.0.0-rc.1", Hash: "c1"}, {Name: "v1.0.0-beta.0", Hash: "c0"}},
+	}
+
+	tracker := TrackPreReleases(&rng)
+
+	commits, err := repo.History(context.Background(), "", "", rng)
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	return SplitByPreReleases(commits, tracker)
+}
+
+func TestSplitByPreReleases(t *testing.T) {
+	repo := newChainRepository()
+	repo.addChain("", "c1", "c2", "c3", "c4", "c5")
+
+	final, preReleases := splitTestHistory(t, repo)
+
+	if expected := []string{"c5", "c4"}; !slices.Equal(expected, hashes(final)) {
+		t.Errorf("Expected final commits %v, got %v.", expected, hashes(final))
+	}
+
+	if len(preReleases) != 2 {
+		t.Fatalf("Expected 2 pre-releases, got %d.", len(preReleases))
+	}
+
+	if expected := []string{"c3", "c2"}; preReleases[0].Tag.Name != "v1.0.0-rc.2" || !slices.Equal(expected, hashes(preReleases[0].Commits)) {
+		t.Errorf("Expected %v in v1.0.0-rc.2, got %v in %s.", expected, hashes(preReleases[0].Commits), preReleases[0].Tag.Name)
+	}
+
+	if expected := []string{"c1"}; preReleases[1].Tag.Name != "v1.0.0-rc.1" || !slices.Equal(expected, hashes(preReleases[1].Commits)) {
+		t.Errorf("Expected %v in v1.0.0-rc.1, got %v in %s.", expected, hashes(preReleases[1].Commits), preReleases[1].Tag.Name)
+	}
+}
+
+func TestSplitByPreReleasesTaggedOnDirectCommit(t *testing.T) {
+	// c3 has been pushed directly and is skipped by the history, but its
+	// tag still has to start the v1.0.0-rc.2 group
+	repo := newChainRepository()
+	repo.addChain("", "c1", "c2")
+	repo.AddCommit(types.Commit{Hash: "c3"}, "c2")
+	repo.addChain("c3", "c4", "c5")
+
+	final, preReleases := splitTestHistory(t, repo)
+
+	if expected := []string{"c5", "c4"}; !slices.Equal(expected, hashes(final)) {
+		t.Errorf("Expected final commits %v, got %v.", expected, hashes(final))
+	}
+
+	if len(preReleases) != 2 {
+		t.Fatalf("Expected 2 pre-releases, got %d.", len(preReleases))
+	}
+
+	if expected := []string{"c2"}; preReleases[0].Tag.Name != "v1.0.0-rc.2" || !slices.Equal(expected, hashes(preReleases[0].Commits)) {
+		t.Errorf("Expected %v in v1.0.0-rc.2, got %v in %s.", expected, hashes(preReleases[0].Commits), preReleases[0].Tag.Name)
+	}
+}
+
+// withoutMergeBase hides the MergeBaser implementation of a source.
+type withoutMergeBase struct {
+	source.Source
+}
+
+func TestDetermineRangeWithLongPreviousReleaseBranch(t *testing.T) {
+	// main:         c1 - f - m1 - m2
+	// release/v1.0:      f - p1 - ... - p300
+	repo := newChainRepository()
+	patches := []string{}
+	for i := 1; i <= 300; i++ {
+		patches = append(patches, fmt.Sprintf("p%d", i))
+	}
+
+	repo.addChain("", "c1", "f", "m1", "m2")
+	repo.addChain("f", patches...)
+
+	repo.AddBranch("main", "m2")
+	repo.AddBranch("release/v1.0", "p300")
+
+	sources := map[string]source.Source{
+		"merge base":      repo,
+		"walking history": withoutMergeBase{Source: repo},
+	}
+
+	for name, client := range sources {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			rng, err := DetermineRange(ctx, client, logrus.New(), &types.Options{ForVersion: "1.1.0"})
+			if err != nil {
+				t.Fatalf("Failed to determine range: %v", err)
+			}
+
+			commits, err := client.History(ctx, "", "", rng)
+			if err != nil {
+				t.Fatalf("Failed to fetch history: %v", err)
+			}
+
+			if expected := []string{"m2", "m1"}; !slices.Equal(expected, hashes(commits)) {
+				t.Fatalf("Expected commits %v, got %v.", expected, hashes(commits))
+			}
+		})
+	}
+}
+
+// mergedBranch is a repository in which a side branch has been merged into
+// the head. As the Memory does not know about merge commits, the merge base is
+// faked to be on the side branch.
+type mergedBranch struct {
+	*source.Memory
+	base string
+}
+
+func (m mergedBranch) MergeBase(_ context.Context, _ string, _ string, _ string, _ string) (string, error) {
+	return m.base, nil
+}
+
+func TestDetermineRangeWithStartOffFirstParentHistory(t *testing.T) {
+	// main:    m1 - m2 - m3 - m4 (merges f2)
+	// feature: m1 - f1 - f2
+	repo := newChainRepository()
+	repo.addChain("", "m1", "m2", "m3", "m4")
+	repo.addChain("m1", "f1", "f2")
+
+	repo.AddBranch("main", "m4")
+	repo.AddBranch("feature", "f2")
+
+	ctx := context.Background()
+	client := mergedBranch{Memory: repo.Memory, base: "f2"}
+
+	rng, err := DetermineRange(ctx, client, logrus.New(), &types.Options{ForVersion: "1.1.0", Start: "feature"})
+	if err != nil {
+		t.Fatalf("Failed to determine range: %v", err)
+	}
+
+	commits, err := client.History(ctx, "", "", rng)
+	if err == nil {
+		t.Fatalf("Expected an error for a merge base off the first-parent history, but got commits %v.", hashes(commits))
+	}
+
+	if rng.Boundary.Hash != "" {
+		t.Errorf("Expected no boundary, got %+v.", *rng.Boundary)
+	}
+}
+
+// countingLog counts the commits returned by Log. It hides the MergeBase
+// of the source, so that the fork point is found by walking both histories.
+type countingLog struct {
+	source.Source
+	fetched *int
+}
+
+func (c countingLog) Log(ctx context.Context, owner string, name string, headHash string, maxCommits int) ([]types.Commit, error) {
+	commits, err := c.Source.Log(ctx, owner, name, headHash, maxCommits)
+	*c.fetched += len(commits)
+
+	return commits, err
+}
+
+func TestDetermineRangeContinuesLog(t *testing.T) {
+	// main:         c1 - f - m1 - m2
+	// release/v1.0:      f - p1 - ... - p1000
+	repo := newChainRepository()
+	patches := []string{}
+	for i := 1; i <= 1000; i++ {
+		patches = append(patches, fmt.Sprintf("p%d", i))
+	}
+
+	repo.addChain("", "c1", "f", "m1", "m2")
+	repo.addChain("f", patches...)
+
+	repo.AddBranch("main", "m2")
+	repo.AddBranch("release/v1.0", "p1000")
+
+	ctx := context.Background()
+	fetched := 0
+	client := countingLog{Source: repo, fetched: &fetched}
+
+	rng, err := DetermineRange(ctx, client, logrus.New(), &types.Options{ForVersion: "1.1.0"})
+	if err != nil {
+		t.Fatalf("Failed to determine range: %v", err)
+	}
+
+	if _, err := client.History(ctx, "", "", rng); err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	// Without merge bases, both histories are walked entirely (1002 and 4
+	// commits), and every further chunk repeats the last commit of the
+	// previous one.
+	chunks := 1002/logChunkSize + 1
+	if maxFetched := 1002 + 4 + chunks; fetched > maxFetched {
+		t.Errorf("Expected at most %d commits to be fetched, got %d.", maxFetched, fetched)
+	}
+}
+
+func TestDetermineRangeWithDistantForkPoint(t *testing.T) {
+	// main:         c1 - m1
+	// release/v1.0: c1 - p1 - ... - p10000, with a fork point that is not
+	//               part of its first-parent history
+	repo := newChainRepository()
+	patches := []string{}
+	for i := 1; i <= maxForkPointDistance; i++ {
+		patches = append(patches, fmt.Sprintf("p%d", i))
+	}
+
+	repo.addChain("", "c1", "m1")
+	repo.addChain("c1", patches...)
+	repo.addChain("c1", "x1")
+
+	repo.AddBranch("main", "m1")
+	repo.AddBranch("release/v1.0", patches[len(patches)-1])
+
+	ctx := context.Background()
+	client := mergedBranch{Memory: repo.Memory, base: "x1"}
+
+	if _, err := DetermineRange(ctx, client, logrus.New(), &types.Options{ForVersion: "1.1.0"}); err == nil {
+		t.Fatal("Expected an error for a fork point beyond the limit, but got none.")
+	}
+}
+
+func TestDetermineRangeWithCustomNamingScheme(t *testing.T) {
+	// main:        m1 - m2 - m3 - m4
+	// release-1.0: m1 - r1            (chart-v1.0.1 is tagged on r1)
+	// release-1.1:           m3 - s1  (chart-v1.1.0 is tagged on m3)
+	repo := newChainRepository()
+	repo.addChain("", "m1", "m2", "m3", "m4")
+	repo.addChain("m1", "r1")
+	repo.addChain("m3", "s1")
+
+	repo.AddBranch("main", "m4")
+	repo.AddBranch("release-1.0", "r1")
+	repo.AddBranch("release-1.1", "s1")
+	repo.AddTag("chart-v1.0.1", "r1")
+	repo.AddTag("chart-v1.1.0", "m3")
+
+	testcases := []struct {
+		name     string
+		version  string
+		branch   string
+		expected []string
+	}{
+		{
+			name:     "release branch stops at the previous release branch",
+			version:  "1.1.0",
+			branch:   "release-1.1",
+			expected: []string{"m3", "m2"},
+		},
+		{
+			name:     "patch release stops at the previous tag",
+			version:  "1.1.1",
+			branch:   "release-1.1",
+			expected: []string{"s1"},
+		},
+		{
+			name:     "new minor release uses the primary branch",
+			version:  "1.2.0",
+			branch:   "main",
+			expected: []string{"m4"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			opts := &types.Options{
+				ForVersion:     testcase.version,
+				BranchTemplate: "release-{{major}}.{{minor}}",
+				TagTemplate:    "chart-v{{version}}",
+			}
+
+			rng, err := DetermineRange(ctx, repo, logrus.New(), opts)
+			if err != nil {
+				t.Fatalf("Failed to determine range: %v", err)
+			}
+
+			if rng.Branch != testcase.branch {
+				t.Errorf("Expected branch %q, got %q.", testcase.branch, rng.Branch)
+			}
+
+			commits, err := repo.History(ctx, "", "", rng)
+			if err != nil {
+				t.Fatalf("Failed to fetch history: %v", err)
+			}
+
+			if !slices.Equal(testcase.expected, hashes(commits)) {
+				t.Fatalf("Expected commits %v, got %v.", testcase.expected, hashes(commits))
+			}
+		})
+	}
+}
+
+func TestDetermineRangeWithUnprefixedTags(t *testing.T) {
+	// main: m1 - m2 - m3 - m4  (1.0.0 is tagged on m1, 1.1.0 on m3)
+	repo := newChainRepository()
+	repo.addChain("", "m1", "m2", "m3", "m4")
+
+	repo.AddBranch("main", "m4")
+	repo.AddTag("1.0.0", "m1")
+	repo.AddTag("1.1.0", "m3")
+
+	ctx := context.Background()
+	opts := &types.Options{
+		ForVersion:              "1.1.0",
+		TagTemplate:             types.DefaultTagTemplate,
+		PreviousVersionStrategy: types.PreviousVersionFromTags,
+	}
+
+	rng, err := DetermineRange(ctx, repo, logrus.New(), opts)
+	if err != nil {
+		t.Fatalf("Failed to determine range: %v", err)
+	}
+
+	if rng.HeadRef != "1.1.0" || rng.PreviousRelease != "1.0.0" {
+		t.Errorf("Expected range from 1.1.0 to 1.0.0, got %q to %q.", rng.HeadRef, rng.PreviousRelease)
+	}
+
+	commits, err := repo.History(ctx, "", "", rng)
+	if err != nil {
+		t.Fatalf("Failed to fetch history: %v", err)
+	}
+
+	if expected := []string{"m3", "m2"}; !slices.Equal(expected, hashes(commits)) {
+		t.Fatalf("Expected commits %v, got %v.", expected, hashes(commits))
+	}
+}
+
+func TestDetermineRangeForComponent(t *testing.T) {
+	// main: m1 - m2 - m3 - m4
+	//
+	// sdk/v1.0.0 is tagged on m1, api/v0.9.0 on m2 and sdk/v1.1.0 on m3.
+	repo := newChainRepository()
+	repo.addChain("", "m1", "m2", "m3", "m4")
+
+	repo.AddBranch("main", "m4")
+	repo.AddTag("sdk/v1.0.0", "m1")
+	repo.AddTag("api/v0.9.0", "m2")
+	repo.AddTag("sdk/v1.1.0", "m3")
+
+	testcases := []struct {
+		name     string
+		version  string
+		expected []string
+	}{
+		{
+			name:     "tags of other components are ignored",
+			version:  "1.1.0",
+			expected: []string{"m3", "m2"},
+		},
+		{
+			name:     "unreleased version starts at the previous tag",
+			version:  "1.2.0",
+			expected: []string{"m4"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			opts := &types.Options{
+				ForVersion:              testcase.version,
+				Component:               "sdk",
+				PreviousVersionStrategy: types.PreviousVersionFromTags,
+			}
+
+			rng, err := DetermineRange(ctx, repo, logrus.New(), opts)
+			if err != nil {
+				t.Fatalf("Failed to determine range: %v", err)
+			}
+
+			commits, err := repo.History(ctx, "", "", rng)
+			if err != nil {
+				t.Fatalf("Failed to fetch history: %v", err)
+			}
+
+			if !slices.Equal(testcase.expected, hashes(commits)) {
+				t.Fatalf("Expected commits %v, got %v.", testcase.expected, hashes(commits))
+			}
+		})
+	}
+}
+
+func TestDetermineRangeBoundaries(t *testing.T) {
+	testcases := []struct {
+		name     string
+		opts     types.Options
+		expected types.Boundary
+	}{
+		{
+			name:     "previous release branch",
+			opts:     types.Options{ForVersion: "1.2.0"},
+			expected: types.Boundary{Hash: "m3", Reason: types.StopReasonPreviousRelease, Ref: "release/v1.1"},
+		},
+		{
+			name:     "previous tag",
+			opts:     types.Options{ForVersion: "1.1.1"},
+			expected: types.Boundary{Hash: "r1", Reason: types.StopReasonTag, Ref: "v1.1.0"},
+		},
+		{
+			name:     "custom end commit",
+			opts:     types.Options{ForVersion: "1.3.0", End: "m4"},
+			expected: types.Boundary{Hash: "m4", Reason: types.StopReasonEnd, Ref: "m4"},
+		},
+		{
+			name:     "custom start tag",
+			opts:     types.Options{ForVersion: "1.3.0", Start: "v1.1.0"},
+			expected: types.Boundary{Hash: "m3", Reason: types.StopReasonStart, Ref: "v1.1.0"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newTestRepository()
+
+			rng, err := DetermineRange(ctx, repo, logrus.New(), &testcase.opts)
+			if err != nil {
+				t.Fatalf("Failed to determine range: %v", err)
+			}
+
+			commits, err := repo.History(ctx, "", "", rng)
+			if err != nil {
+				t.Fatalf("Failed to fetch history: %v", err)
+			}
+
+			// pretend that only the newest commit made it into the changelog
+			explanation := Explain(rng, commits, commits[:1])
+
+			if explanation.Boundary == nil {
+				t.Fatal("Expected a boundary, but the range has none.")
+			}
+
+			if *explanation.Boundary != testcase.expected {
+				t.Errorf("Expected boundary %+v, got %+v.", testcase.expected, *explanation.Boundary)
+			}
+
+			if len(explanation.Commits) != len(commits) {
+				t.Fatalf("Expected %d commits, got %d.", len(commits), len(explanation.Commits))
+			}
+
+			for i, commit := range explanation.Commits {
+				if commit.Included != (i == 0) {
+					t.Errorf("Expected commit %s to be included: %v, got %v.", commit.Hash, i == 0, commit.Included)
+				}
+			}
+		})
+	}
+}
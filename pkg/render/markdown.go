@@ -23,6 +23,7 @@ import (
 	"text/template"
 
 	"k8c.io/gchl/pkg/changelog"
+	"k8c.io/gchl/pkg/types"
 
 	"github.com/go-openapi/inflect"
 )
@@ -34,9 +35,9 @@ func NewMarkdownRenderer() Renderer {
 }
 
 var markdownTemplate = `
-## v{{ .Version }}
+## {{ with .Component }}{{ . }}/{{ end }}v{{ .Version }}
 
-**GitHub release: [v{{ .Version }}]({{ .RepositoryURL }}/releases/tag/v{{ .Version }})**
+**Release: [{{ with .Component }}{{ . }}/{{ end }}v{{ .Version }}]({{ releaselink }})**
 {{- $breaking := .BreakingChanges }}
 {{- if $breaking }}
 
@@ -44,13 +45,21 @@ var markdownTemplate = `
 
 This release contains changes that require additional attention, please read the following items carefully.
 {{ range $breaking }}
-- {{ .Text }} ([#{{ .Commit.PullRequest.Number }}]({{ prlink .Commit.PullRequest.Number }}))
+- {{ .Text }} ({{ reference .Commit }}{{ range .Issues }}, fixes {{ issuelink . }}{{ end }})
 {{- end }}
 {{- end }}
 {{ range .ChangeGroups }}
 ### {{ typename .Type }}
 {{ range .Changes }}
-- {{ .Text }} ([#{{ .Commit.PullRequest.Number }}]({{ prlink .Commit.PullRequest.Number }}))
+- {{ .Text }} ({{ reference .Commit }}{{ range .Issues }}, fixes {{ issuelink . }}{{ end }})
+{{- end }}
+{{ end }}
+{{- with .Contributors }}
+### Contributors
+
+Thanks to everyone who contributed to this release!
+{{ range . }}
+- {{ if .Login }}@{{ .Login }}{{ else }}{{ .Name }}{{ end }}{{ if .FirstTime }} (first contribution){{ end }}
 {{- end }}
 {{ end }}
 `
@@ -69,9 +78,46 @@ var overriddenTypeNames = map[changelog.ChangeType]string{
 }
 
 func (m *markdown) Render(log *changelog.Changelog) (string, error) {
+	prlink := func(pr types.PullRequest) string {
+		if pr.URL != "" {
+			return pr.URL
+		}
+
+		return fmt.Sprintf("%s/pull/%d", log.RepositoryURL, pr.Number)
+	}
+
+	commitlink := func(commit types.Commit) string {
+		if commit.URL != "" {
+			return commit.URL
+		}
+
+		return fmt.Sprintf("%s/commit/%s", log.RepositoryURL, commit.Hash)
+	}
+
 	t := template.New("changelog").Funcs(template.FuncMap{
-		"prlink": func(number int) string {
-			return fmt.Sprintf("%s/pull/%d", log.RepositoryURL, number)
+		// reference links to the pull request, or to the commit itself if it
+		// was pushed without a pull request
+		"reference": func(commit types.Commit) string {
+			if !commit.HasPullRequest() {
+				return fmt.Sprintf("[`%s`](%s)", types.ShortHash(commit.Hash), commitlink(commit))
+			}
+
+			return fmt.Sprintf("[#%d](%s)", commit.PullRequest.Number, prlink(commit.PullRequest))
+		},
+		"issuelink": func(issue types.Issue) string {
+			url := issue.URL
+			if url == "" {
+				url = fmt.Sprintf("%s/issues/%d", log.RepositoryURL, issue.Number)
+			}
+
+			return fmt.Sprintf("[#%d](%s)", issue.Number, url)
+		},
+		"releaselink": func() string {
+			if log.ReleaseURL != "" {
+				return log.ReleaseURL
+			}
+
+			return fmt.Sprintf("%s/releases/tag/v%s", log.RepositoryURL, log.Version)
 		},
 		"typename": func(changeType changelog.ChangeType) string {
 			if known, ok := overriddenTypeNames[changeType]; ok {
@@ -93,7 +139,20 @@ func (m *markdown) Render(log *changelog.Changelog) (string, error) {
 	}
 
 	var b bytes.Buffer
-	err = t.Execute(&b, log)
+	if err := t.Execute(&b, log); err != nil {
+		return "", err
+	}
+
+	// changes that first appeared in a pre-release are listed in their own sections
+	for _, pre := range log.PreReleases {
+		rendered, err := m.Render(pre)
+		if err != nil {
+			return "", fmt.Errorf("failed to render v%s: %w", pre.Version, err)
+		}
+
+		b.WriteString("\n\n")
+		b.WriteString(rendered)
+	}
 
-	return b.String(), err
+	return b.String(), nil
 }
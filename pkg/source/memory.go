@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+// Memory is a Source that keeps a single repository in memory. It is meant
+// for tests and ignores the owner and name arguments of all functions.
+type Memory struct {
+	refs         types.RepositoryRefs
+	releases     []types.Release
+	commits      map[string]memoryCommit
+	pullRequests map[int]types.PullRequest
+}
+
+type memoryCommit struct {
+	commit types.Commit
+	parent string
+}
+
+var (
+	_ Source        = &Memory{}
+	_ ReleaseSource = &Memory{}
+	_ MergeBaser    = &Memory{}
+)
+
+func NewMemory(defaultBranch string) *Memory {
+	return &Memory{
+		refs: types.RepositoryRefs{
+			DefaultBranch: defaultBranch,
+		},
+		commits:      map[string]memoryCommit{},
+		pullRequests: map[int]types.PullRequest{},
+	}
+}
+
+// AddCommit records a commit whose first parent is the given parent hash.
+// The parent can be empty for root commits. If the commit references a
+// pull request, the pull request is recorded as well.
+func (m *Memory) AddCommit(commit types.Commit, parent string) {
+	m.commits[commit.Hash] = memoryCommit{
+		commit: commit,
+		parent: parent,
+	}
+
+	if commit.PullRequest.Number != 0 {
+		m.AddPullRequest(commit.PullRequest)
+	}
+}
+
+func (m *Memory) AddPullRequest(pr types.PullRequest) {
+	m.pullRequests[pr.Number] = pr
+}
+
+func (m *Memory) AddBranch(name string, hash string) {
+	m.refs.Branches = append(m.refs.Branches, types.Ref{Name: name, Hash: hash})
+}
+
+func (m *Memory) AddTag(name string, hash string) {
+	m.refs.Tags = append(m.refs.Tags, types.Ref{Name: name, Hash: hash})
+}
+
+func (m *Memory) AddRelease(release types.Release) {
+	m.releases = append(m.releases, release)
+}
+
+func (m *Memory) Releases(_ context.Context, _ string, _ string) ([]types.Release, error) {
+	return m.releases, nil
+}
+
+func (m *Memory) References(_ context.Context, _ string, _ string) (types.RepositoryRefs, error) {
+	return m.refs, nil
+}
+
+func (m *Memory) History(_ context.Context, _ string, _ string, rng types.Range) ([]types.Commit, error) {
+	commits := []types.Commit{}
+
+	var stopErr error
+
+	err := m.walk(rng.Head, func(commit types.Commit) bool {
+		stopped, err := rng.Stop(commit)
+		if err != nil {
+			stopErr = err
+			return false
+		}
+
+		if stopped {
+			return false
+		}
+
+		if !commit.HasPullRequest() && !rng.IncludeDirectCommits {
+			return true
+		}
+
+		commits = append(commits, commit)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if stopErr != nil {
+		return nil, stopErr
+	}
+
+	return commits, nil
+}
+
+// Log returns up to maxCommits commits. Like for the other sources, the log
+// contains all commits, including those without a pull request.
+func (m *Memory) Log(_ context.Context, _ string, _ string, headHash string, maxCommits int) ([]types.Commit, error) {
+	commits := []types.Commit{}
+
+	err := m.walk(headHash, func(commit types.Commit) bool {
+		commits = append(commits, commit)
+		return len(commits) < maxCommits
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// walk follows the first parents, beginning with head, and calls the callback
+// for every commit until it returns false.
+func (m *Memory) walk(head string, callback func(types.Commit) bool) error {
+	for hash := head; hash != ""; {
+		c, ok := m.commits[hash]
+		if !ok {
+			return fmt.Errorf("commit %q does not exist", hash)
+		}
+
+		if !callback(c.commit) {
+			break
+		}
+
+		hash = c.parent
+	}
+
+	return nil
+}
+
+// MergeBase only considers the first parents, as the Memory does not know
+// about merge commits.
+func (m *Memory) MergeBase(_ context.Context, _ string, _ string, a string, b string) (string, error) {
+	ancestors := map[string]struct{}{}
+
+	err := m.walk(a, func(commit types.Commit) bool {
+		ancestors[commit.Hash] = struct{}{}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	base := ""
+
+	err = m.walk(b, func(commit types.Commit) bool {
+		if _, ok := ancestors[commit.Hash]; ok {
+			base = commit.Hash
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if base == "" {
+		return "", fmt.Errorf("commits %q and %q have no common ancestor", a, b)
+	}
+
+	return base, nil
+}
+
+func (m *Memory) FetchBatchPullRequests(_ context.Context, _ string, _ string, numbers []int) (map[int]types.PullRequest, error) {
+	result := map[int]types.PullRequest{}
+
+	for _, number := range numbers {
+		if pr, ok := m.pullRequests[number]; ok {
+			result[number] = pr
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+
+	"k8c.io/gchl/pkg/types"
+)
+
+// Source is a backend that provides the repository data needed to build
+// a changelog. It is implemented by the GitHub client, but can just as well
+// be a different forge or a fake.
+type Source interface {
+	PullRequestSource
+
+	// References returns the default branch and all branches and tags.
+	References(ctx context.Context, owner string, name string) (types.RepositoryRefs, error)
+
+	// History returns all commits in the range, i.e. beginning with the head
+	// hash until the stop function returns true. Commits without a pull request
+	// are skipped, unless the range includes direct commits.
+	History(ctx context.Context, owner string, name string, rng types.Range) ([]types.Commit, error)
+
+	// Log returns up to maxCommits commits, beginning with the head hash.
+	Log(ctx context.Context, owner string, name string, headHash string, maxCommits int) ([]types.Commit, error)
+}
+
+// PullRequestSource is the part of a Source that provides pull request
+// details. Backends that only know about commits (like a local clone) rely
+// on a forge for this.
+type PullRequestSource interface {
+	// FetchBatchPullRequests returns the pull requests for the given numbers.
+	// Pull requests that do not exist are not included in the result.
+	FetchBatchPullRequests(ctx context.Context, owner string, name string, numbers []int) (map[int]types.PullRequest, error)
+}
+
+// ContributorSource is optionally implemented by sources that can tell
+// whether a user has contributed to the repository before.
+type ContributorSource interface {
+	// FirstMergedPullRequests returns the number of the first merged pull
+	// request for each of the given authors. Authors without any merged pull
+	// request are not included in the result.
+	FirstMergedPullRequests(ctx context.Context, owner string, name string, authors []string) (map[string]int, error)
+}
+
+// Searcher is optionally implemented by sources that can find pull requests
+// by a search query, independent of the commit history.
+type Searcher interface {
+	// SearchMergedPullRequests returns the merge commits of all merged pull
+	// requests matching the query. The query uses the forge's search syntax.
+	SearchMergedPullRequests(ctx context.Context, owner string, name string, query string) ([]types.Commit, error)
+}
+
+// ReleaseSource is optionally implemented by sources that know about the
+// releases published for a repository (e.g. GitHub Releases).
+type ReleaseSource interface {
+	// Releases returns all releases, including drafts and pre-releases.
+	Releases(ctx context.Context, owner string, name string) ([]types.Release, error)
+}
+
+// FileSource is optionally implemented by sources that know which files
+// were changed by a pull request.
+type FileSource interface {
+	// PullRequestFiles returns the paths of all files changed by each of the
+	// given pull requests.
+	PullRequestFiles(ctx context.Context, owner string, name string, numbers []int) (map[int][]string, error)
+}
+
+// MergeBaser is optionally implemented by sources that can determine the
+// best common ancestor of two commits.
+type MergeBaser interface {
+	// MergeBase returns the hash of the merge base of a and b, which can be
+	// any commit-ish (hashes, branch or tag names).
+	MergeBase(ctx context.Context, owner string, name string, a string, b string) (string, error)
+}
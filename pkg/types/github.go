@@ -16,18 +16,89 @@ limitations under the License.
 
 package types
 
+import (
+	"strings"
+	"time"
+)
+
 type Commit struct {
-	Hash        string      `yaml:"hash" json:"hash"`
-	Title       string      `yaml:"title" json:"title"`
+	Hash  string `yaml:"hash" json:"hash"`
+	Title string `yaml:"title" json:"title"`
+	// Message is the full commit message, including trailers like
+	// "Co-authored-by".
+	Message     string      `yaml:"message,omitempty" json:"message,omitempty"`
+	URL         string      `yaml:"url,omitempty" json:"url,omitempty"`
 	PullRequest PullRequest `yaml:"pullRequest" json:"pullRequest"`
 	Author      string      `yaml:"author" json:"author"`
 }
 
+// HasPullRequest returns false for commits that have been pushed directly
+// to a branch instead of being merged via a pull request.
+func (c Commit) HasPullRequest() bool {
+	return c.PullRequest.Number != 0
+}
+
+// shortHashLength matches the abbreviation used by git and GitHub.
+const shortHashLength = 7
+
+// ShortHash returns the abbreviated commit hash for display.
+func ShortHash(hash string) string {
+	if len(hash) > shortHashLength {
+		return hash[:shortHashLength]
+	}
+
+	return hash
+}
+
 type PullRequest struct {
-	Number int      `yaml:"number" json:"number"`
-	Title  string   `yaml:"title" json:"title"`
-	Body   string   `yaml:"body" json:"body"`
-	Labels []string `yaml:"labels" json:"labels"`
+	Number     int        `yaml:"number" json:"number"`
+	Title      string     `yaml:"title" json:"title"`
+	Body       string     `yaml:"body" json:"body"`
+	Author     string     `yaml:"author" json:"author"`
+	AuthorType AuthorType `yaml:"authorType,omitempty" json:"authorType,omitempty"`
+	URL        string     `yaml:"url" json:"url"`
+	Labels     []string   `yaml:"labels" json:"labels"`
+	MergedAt   *time.Time `yaml:"mergedAt,omitempty" json:"mergedAt,omitempty"`
+	MergedBy   string     `yaml:"mergedBy,omitempty" json:"mergedBy,omitempty"`
+	BaseBranch string     `yaml:"baseBranch,omitempty" json:"baseBranch,omitempty"`
+	HeadBranch string     `yaml:"headBranch,omitempty" json:"headBranch,omitempty"`
+	Milestone  string     `yaml:"milestone,omitempty" json:"milestone,omitempty"`
+	// Issues are the issues that the pull request closes.
+	Issues []Issue `yaml:"issues,omitempty" json:"issues,omitempty"`
+}
+
+type Issue struct {
+	Number int    `yaml:"number" json:"number"`
+	Title  string `yaml:"title" json:"title"`
+	URL    string `yaml:"url,omitempty" json:"url,omitempty"`
+}
+
+// AuthorType distinguishes pull requests opened by humans from those
+// opened by bots like Dependabot.
+type AuthorType string
+
+const (
+	AuthorTypeUser AuthorType = "user"
+	AuthorTypeBot  AuthorType = "bot"
+)
+
+// GuessAuthorType is used for forges that do not explicitly mark bot
+// accounts and relies on the "[bot]" suffix used by GitHub Apps.
+func GuessAuthorType(login string) AuthorType {
+	if strings.HasSuffix(strings.ToLower(login), "[bot]") {
+		return AuthorTypeBot
+	}
+
+	return AuthorTypeUser
+}
+
+// Release is a release published on a forge, e.g. a GitHub Release.
+type Release struct {
+	Name       string
+	Tag        string
+	Hash       string
+	Draft      bool
+	Prerelease bool
 }
 
 type RepositoryRefs struct {
@@ -40,3 +111,71 @@ type Ref struct {
 	Name string
 	Hash string
 }
+
+// Stopper is used when walking the commit history and returns true
+// once the given commit should not be included in the history anymore.
+// Returning an error aborts the walk.
+type Stopper func(Commit) (bool, error)
+
+// Range describes the commits that make up a release: the first-parent history
+// beginning at the head commit, until the stopper returns true.
+type Range struct {
+	// Head is the hash of the newest commit in the range.
+	Head string
+	// Branch is the branch the head commit is on. It is used to pick the right
+	// pull request if a commit is associated with several (e.g. the original
+	// and its cherry-pick). It can be empty if the branch is not known.
+	Branch string
+	// Stop determines where the range ends. It is called for every commit,
+	// including those without a pull request, so that a range can end on a
+	// direct push.
+	Stop Stopper
+	// IncludeDirectCommits controls whether commits without a pull request
+	// (e.g. direct pushes to a release branch) are part of the range. By
+	// default they are skipped.
+	IncludeDirectCommits bool
+	// PreReleases are the tags of the pre-releases of the version (newest
+	// first), if its changes should be grouped by pre-release.
+	PreReleases []Ref
+
+	// HeadRef is the name of the tag or branch the head was resolved from.
+	HeadRef string
+	// PreviousRelease is the name of the previous release branch, tag or
+	// release and PreviousReleaseHash its newest commit. Both are empty if
+	// the range is not bounded by the previous release.
+	PreviousRelease     string
+	PreviousReleaseHash string
+	// Boundary is set by the stopper once it ends the range. It stays empty
+	// if the history ended before.
+	Boundary *Boundary
+}
+
+// StopReason describes why a range ended at a commit.
+type StopReason string
+
+const (
+	// StopReasonTag means the commit has been tagged for another version.
+	StopReasonTag StopReason = "tag"
+	// StopReasonPreviousRelease means the commit is part of the previous
+	// release.
+	StopReasonPreviousRelease StopReason = "previous-release"
+	// StopReasonEnd means the commit has been given via --end.
+	StopReasonEnd StopReason = "end"
+	// StopReasonStart means the commit is the merge base with --start.
+	StopReasonStart StopReason = "start"
+)
+
+// Boundary is the first commit that is not part of a range anymore.
+type Boundary struct {
+	Hash   string     `json:"hash"`
+	Reason StopReason `json:"reason"`
+	// Ref is the tag, previous release or flag value that caused the stop.
+	Ref string `json:"ref,omitempty"`
+}
+
+// Record stores where and why the range ended.
+func (b *Boundary) Record(hash string, reason StopReason, ref string) {
+	b.Hash = hash
+	b.Reason = reason
+	b.Ref = ref
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	DefaultBranchTemplate = "release/v{{major}}.{{minor}}"
+	DefaultTagTemplate    = "v{{version}}"
+)
+
+var (
+	placeholderRegex = regexp.MustCompile(`{{\s*([a-z]+)\s*}}`)
+
+	placeholderPatterns = map[string]string{
+		"major":   `[0-9]+`,
+		"minor":   `[0-9]+`,
+		"patch":   `[0-9]+`,
+		"version": `[0-9]+\.[0-9]+\.[0-9]+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?`,
+	}
+)
+
+// NamingScheme describes how release branches and tags are named, based on
+// templates like "release/v{{major}}.{{minor}}" and "v{{version}}".
+type NamingScheme struct {
+	branchTemplate string
+	tagTemplate    string
+	branchRegex    *regexp.Regexp
+	tagRegex       *regexp.Regexp
+	// lenientTags is set for the default tag template, whose tags are parsed
+	// like any semver, with or without the "v" prefix.
+	lenientTags bool
+}
+
+// NewNamingScheme parses the templates for release branches and tags. Empty
+// templates are replaced with the defaults. Branch templates must contain
+// {{major}} and {{minor}}, tag templates either {{version}} or all of
+// {{major}}, {{minor}} and {{patch}}. Tags of the default template are parsed
+// leniently, so "1.2.3" and "v1.2" are recognized as well.
+func NewNamingScheme(branchTemplate string, tagTemplate string) (*NamingScheme, error) {
+	if branchTemplate == "" {
+		branchTemplate = DefaultBranchTemplate
+	}
+
+	if tagTemplate == "" {
+		tagTemplate = DefaultTagTemplate
+	}
+
+	branchRegex, placeholders, err := compileTemplate(branchTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid branch template %q: %w", branchTemplate, err)
+	}
+
+	if !placeholders["major"] || !placeholders["minor"] || placeholders["patch"] || placeholders["version"] {
+		return nil, fmt.Errorf("invalid branch template %q: must contain {{major}} and {{minor}}, but neither {{patch}} nor {{version}}", branchTemplate)
+	}
+
+	tagRegex, placeholders, err := compileTemplate(tagTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag template %q: %w", tagTemplate, err)
+	}
+
+	hasParts := placeholders["major"] || placeholders["minor"] || placeholders["patch"]
+	allParts := placeholders["major"] && placeholders["minor"] && placeholders["patch"]
+
+	if placeholders["version"] && hasParts || !placeholders["version"] && !allParts {
+		return nil, fmt.Errorf("invalid tag template %q: must contain either {{version}} or {{major}}, {{minor}} and {{patch}}", tagTemplate)
+	}
+
+	return &NamingScheme{
+		branchTemplate: branchTemplate,
+		tagTemplate:    tagTemplate,
+		branchRegex:    branchRegex,
+		tagRegex:       tagRegex,
+		lenientTags:    tagTemplate == DefaultTagTemplate,
+	}, nil
+}
+
+// compileTemplate turns a template into an anchored regular expression with
+// one named group per placeholder.
+func compileTemplate(template string) (*regexp.Regexp, map[string]bool, error) {
+	placeholders := map[string]bool{}
+	pattern := strings.Builder{}
+	pattern.WriteString("^")
+
+	last := 0
+	for _, match := range placeholderRegex.FindAllStringSubmatchIndex(template, -1) {
+		name := template[match[2]:match[3]]
+
+		groupPattern, ok := placeholderPatterns[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown placeholder {{%s}}", name)
+		}
+
+		if placeholders[name] {
+			return nil, nil, fmt.Errorf("placeholder {{%s}} must not be used more than once", name)
+		}
+		placeholders[name] = true
+
+		pattern.WriteString(regexp.QuoteMeta(template[last:match[0]]))
+		pattern.WriteString(fmt.Sprintf("(?P<%s>%s)", name, groupPattern))
+
+		last = match[1]
+	}
+
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+
+	regex, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return regex, placeholders, nil
+}
+
+// Branch returns the name of the release branch for a minor version.
+func (n *NamingScheme) Branch(major uint64, minor uint64) string {
+	return render(n.branchTemplate, map[string]string{
+		"major": strconv.FormatUint(major, 10),
+		"minor": strconv.FormatUint(minor, 10),
+	})
+}
+
+// ParseBranch returns the minor version of a release branch. If the branch
+// is not a release branch, false is returned.
+func (n *NamingScheme) ParseBranch(name string) (uint64, uint64, bool) {
+	groups := matchGroups(n.branchRegex, name)
+	if groups == nil {
+		return 0, 0, false
+	}
+
+	major, err := strconv.ParseUint(groups["major"], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	minor, err := strconv.ParseUint(groups["minor"], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// Tag returns the name of the tag for a version.
+func (n *NamingScheme) Tag(version *semver.Version) string {
+	return render(n.tagTemplate, map[string]string{
+		"major":   strconv.FormatUint(version.Major(), 10),
+		"minor":   strconv.FormatUint(version.Minor(), 10),
+		"patch":   strconv.FormatUint(version.Patch(), 10),
+		"version": version.String(),
+	})
+}
+
+// ParseTag returns the version of a release tag. If the tag does not follow
+// the naming scheme, an error is returned.
+func (n *NamingScheme) ParseTag(name string) (*semver.Version, error) {
+	if n.lenientTags {
+		return semver.NewVersion(name)
+	}
+
+	groups := matchGroups(n.tagRegex, name)
+	if groups == nil {
+		return nil, fmt.Errorf("tag %q does not match %q", name, n.tagTemplate)
+	}
+
+	version, ok := groups["version"]
+	if !ok {
+		version = fmt.Sprintf("%s.%s.%s", groups["major"], groups["minor"], groups["patch"])
+	}
+
+	return semver.StrictNewVersion(version)
+}
+
+func render(template string, values map[string]string) string {
+	return placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		return values[placeholderRegex.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+func matchGroups(regex *regexp.Regexp, s string) map[string]string {
+	match := regex.FindStringSubmatch(s)
+	if match == nil {
+		return nil
+	}
+
+	groups := map[string]string{}
+	for i, name := range regex.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+
+	return groups
+}
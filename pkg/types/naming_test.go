@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func TestNamingSchemeTags(t *testing.T) {
+	testcases := []struct {
+		template string
+		tag      string
+		version  string
+		// rendered is the tag rendered for the version, if it differs from tag
+		rendered string
+		invalid  bool
+	}{
+		{template: "", tag: "v1.2.3", version: "1.2.3"},
+		{template: "", tag: "v1.2.3-rc.1", version: "1.2.3-rc.1"},
+		{template: "", tag: "1.2.3", version: "1.2.3", rendered: "v1.2.3"},
+		{template: "", tag: "v1.2", version: "1.2.0", rendered: "v1.2.0"},
+		{template: "", tag: "latest", invalid: true},
+		{template: "v{{version}}", tag: "1.2.3", version: "1.2.3", rendered: "v1.2.3"},
+		{template: "{{version}}", tag: "1.2.3", version: "1.2.3"},
+		{template: "chart-v{{version}}", tag: "chart-v1.2.3", version: "1.2.3"},
+		{template: "chart-v{{version}}", tag: "v1.2.3", invalid: true},
+		{template: "release-{{major}}.{{minor}}.{{patch}}", tag: "release-1.2.3", version: "1.2.3"},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.template+"/"+testcase.tag, func(t *testing.T) {
+			naming, err := NewNamingScheme("", testcase.template)
+			if err != nil {
+				t.Fatalf("Failed to create naming scheme: %v", err)
+			}
+
+			version, err := naming.ParseTag(testcase.tag)
+			if err != nil {
+				if !testcase.invalid {
+					t.Fatalf("Failed to parse tag: %v", err)
+				}
+
+				return
+			}
+
+			if testcase.invalid {
+				t.Fatalf("Expected an error, but got version %s.", version)
+			}
+
+			if version.String() != testcase.version {
+				t.Errorf("Expected version %s, got %s.", testcase.version, version)
+			}
+
+			rendered := testcase.rendered
+			if rendered == "" {
+				rendered = testcase.tag
+			}
+
+			if tag := naming.Tag(version); tag != rendered {
+				t.Errorf("Expected tag %q to be rendered, got %q.", rendered, tag)
+			}
+		})
+	}
+}
+
+func TestNamingSchemeBranches(t *testing.T) {
+	naming, err := NewNamingScheme("release-{{major}}.{{minor}}", "")
+	if err != nil {
+		t.Fatalf("Failed to create naming scheme: %v", err)
+	}
+
+	if branch := naming.Branch(1, 12); branch != "release-1.12" {
+		t.Errorf("Expected branch release-1.12, got %q.", branch)
+	}
+
+	if major, minor, ok := naming.ParseBranch("release-2.3"); !ok || major != 2 || minor != 3 {
+		t.Errorf("Expected release-2.3 to be parsed as 2.3, got %d.%d (%v).", major, minor, ok)
+	}
+
+	if _, _, ok := naming.ParseBranch("release/v2.3"); ok {
+		t.Error("Expected release/v2.3 not to be a release branch.")
+	}
+
+	if tag := naming.Tag(semver.MustParse("1.2.3")); tag != "v1.2.3" {
+		t.Errorf("Expected default tag v1.2.3, got %q.", tag)
+	}
+}
+
+func TestInvalidNamingSchemes(t *testing.T) {
+	testcases := []struct {
+		branch string
+		tag    string
+	}{
+		{branch: "release-{{major}}"},
+		{branch: "release-{{version}}"},
+		{branch: "release-{{major}}.{{minor}}.{{patch}}"},
+		{tag: "v{{major}}.{{minor}}"},
+		{tag: "v{{version}}-{{major}}"},
+		{tag: "v{{version}}{{version}}"},
+		{tag: "v{{semver}}"},
+		{tag: "latest"},
+	}
+
+	for _, testcase := range testcases {
+		if _, err := NewNamingScheme(testcase.branch, testcase.tag); err == nil {
+			t.Errorf("Expected branch template %q and tag template %q to be invalid.", testcase.branch, testcase.tag)
+		}
+	}
+}
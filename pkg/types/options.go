@@ -22,36 +22,199 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/pflag"
 )
 
 type Options struct {
-	Organization string
-	Repository   string
-	ForVersion   string
-	GithubToken  string
-	End          string
-	Verbose      bool
-	OutputFormat string
+	Organization            string
+	Repository              string
+	ForVersion              string
+	Forge                   string
+	GithubURL               string
+	GithubToken             string
+	GithubApp               GithubAppOptions
+	GitlabURL               string
+	GitlabToken             string
+	GiteaURL                string
+	GiteaToken              string
+	Start                   string
+	End                     string
+	Milestone               string
+	PreviousVersionStrategy string
+	PreReleaseMode          string
+	BranchTemplate          string
+	TagTemplate             string
+	Component               string
+	ComponentPaths          []string
+	Query                   string
+	IncludeDirectCommits    bool
+	ExcludeContributors     []string
+	RepoPath                string
+	CacheDir                string
+	RecordDir               string
+	ReplayDir               string
+	RequestTimeout          time.Duration
+	Timeout                 time.Duration
+	Concurrency             int
+	Verbose                 bool
+	OutputFormat            string
 }
 
-var outputFormats = []string{"markdown", "json"}
+type GithubAppOptions struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKeyFile string
+}
+
+func (o *GithubAppOptions) Enabled() bool {
+	return o.AppID != 0
+}
+
+const (
+	// PreviousVersionFromBranches looks for the release branch of the
+	// previous minor version.
+	PreviousVersionFromBranches = "branches"
+	// PreviousVersionFromReleases uses the latest published release before
+	// the version.
+	PreviousVersionFromReleases = "releases"
+	// PreviousVersionFromTags uses the latest tag before the version.
+	PreviousVersionFromTags = "tags"
+)
+
+const (
+	// PreReleasesMerged lists all changes of a final release together,
+	// regardless of the pre-release they first appeared in.
+	PreReleasesMerged = "merged"
+	// PreReleasesGrouped groups the changes of a final release by the
+	// pre-release they first appeared in.
+	PreReleasesGrouped = "grouped"
+)
+
+var (
+	outputFormats             = []string{"markdown", "json"}
+	forges                    = []string{"github", "gitlab", "gitea"}
+	previousVersionStrategies = []string{PreviousVersionFromBranches, PreviousVersionFromReleases, PreviousVersionFromTags}
+	preReleaseModes           = []string{PreReleasesMerged, PreReleasesGrouped}
+)
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.Organization, "organization", "o", "", "Name of the GitHub organization")
 	fs.StringVarP(&o.Repository, "repository", "r", "", "Name of the repository")
 	fs.StringVarP(&o.ForVersion, "for-version", "v", "", "Name of the release to generate the changelog for")
+	fs.StringVar(&o.Start, "start", "", "Tag, branch or commit hash after which the changelog starts (the history is followed back to its merge base with the version)")
 	fs.StringVarP(&o.End, "end", "e", "", "Commit hash where to stop (instead of following the branch until the previous version)")
+	fs.BoolVar(&o.IncludeDirectCommits, "include-direct-commits", false, "Include commits without a pull request, using the release notes from their commit message")
+	fs.StringSliceVar(&o.ExcludeContributors, "exclude-contributors", []string{"dependabot", "renovate", "github-actions"}, "Users (usually bots) that are not listed as contributors")
+	fs.StringVar(&o.PreviousVersionStrategy, "previous-version-strategy", "", fmt.Sprintf("How to find the previous version the changelog starts at (one of %v, defaults to %q or %q with --component)", previousVersionStrategies, PreviousVersionFromBranches, PreviousVersionFromTags))
+	fs.StringVar(&o.PreReleaseMode, "prereleases", PreReleasesMerged, fmt.Sprintf("How to handle the pre-releases of a final release, either list all changes since the previous version at once (%q) or group them by pre-release (%q)", PreReleasesMerged, PreReleasesGrouped))
+	fs.StringVar(&o.BranchTemplate, "branch-template", DefaultBranchTemplate, "Naming scheme of release branches, using the placeholders {{major}} and {{minor}}")
+	fs.StringVar(&o.TagTemplate, "tag-template", DefaultTagTemplate, "Naming scheme of release tags, using the placeholder {{version}} or {{major}}, {{minor}} and {{patch}}")
+	fs.StringVar(&o.Component, "component", "", `Component of a monorepo to generate the changelog for, its tags are prefixed with the component name (e.g. "sdk/v1.2.3")`)
+	fs.StringSliceVar(&o.ComponentPaths, "component-path", nil, "Paths belonging to the component, only pull requests changing files in them are included (defaults to the component name, only with --component)")
+	fs.StringVar(&o.Milestone, "milestone", "", "Collect all merged pull requests in this milestone instead of following the branch history (only with --forge=github)")
+	fs.StringVar(&o.Query, "query", "", `Collect all merged pull requests matching this search query (e.g. "label:foo") instead of following the branch history (only with --forge=github)`)
+	fs.StringVar(&o.Forge, "forge", "github", fmt.Sprintf("Forge hosting the repository (one of %v)", forges))
+	fs.StringVar(&o.GithubURL, "github-url", "https://github.com", "Base URL of the GitHub instance, e.g. for GitHub Enterprise Server (only with --forge=github)")
+	fs.Int64Var(&o.GithubApp.AppID, "github-app-id", 0, "ID of the GitHub App to authenticate as (instead of using $GCHL_GITHUB_TOKEN)")
+	fs.Int64Var(&o.GithubApp.InstallationID, "github-app-installation-id", 0, "ID of the GitHub App installation (required with --github-app-id)")
+	fs.StringVar(&o.GithubApp.PrivateKeyFile, "github-app-private-key", "", "Path to the GitHub App's PEM encoded private key (required with --github-app-id)")
+	fs.StringVar(&o.GitlabURL, "gitlab-url", "https://gitlab.com", "Base URL of the GitLab instance (only with --forge=gitlab)")
+	fs.StringVar(&o.GiteaURL, "gitea-url", "", "Base URL of the Gitea/Forgejo instance (only with --forge=gitea)")
+	fs.StringVar(&o.RepoPath, "repo-path", "", "Path to a local clone to read tags, branches and history from (pull requests are still fetched from the forge)")
+	fs.StringVar(&o.CacheDir, "cache-dir", "", "Directory to cache GitHub API results in across runs (only with --forge=github)")
+	fs.StringVar(&o.RecordDir, "record", "", "Directory to save all GitHub API requests and responses to, for later use with --replay (only with --forge=github)")
+	fs.StringVar(&o.ReplayDir, "replay", "", "Directory to serve previously recorded GitHub API responses from, instead of using the network (only with --forge=github)")
+	fs.DurationVar(&o.RequestTimeout, "request-timeout", 30*time.Second, "Timeout for each individual GitHub API request, failed requests are retried (only with --forge=github)")
+	fs.IntVar(&o.Concurrency, "concurrency", 4, "Maximum number of API requests to run at the same time")
+	fs.DurationVar(&o.Timeout, "timeout", 0, "Timeout for the entire run (0 disables the timeout)")
 	fs.StringVarP(&o.OutputFormat, "format", "f", "markdown", fmt.Sprintf("Output format (one of %v)", outputFormats))
 	fs.BoolVarP(&o.Verbose, "verbose", "V", false, "Enable more verbose logging")
 }
 
 func (o *Options) Parse() error {
-	o.GithubToken = os.Getenv("GCHL_GITHUB_TOKEN")
-	if o.GithubToken == "" {
-		return errors.New("no $GCHL_GITHUB_TOKEN environment variable defined")
+	if o.Forge == "" {
+		o.Forge = "github"
+	}
+
+	switch o.Forge {
+	case "github":
+		if o.RecordDir != "" && o.ReplayDir != "" {
+			return errors.New("--record and --replay cannot be used together")
+		}
+
+		switch {
+		case o.ReplayDir != "":
+			// recorded responses need no authentication
+
+		case o.GithubApp.Enabled():
+			if o.GithubApp.InstallationID == 0 {
+				return errors.New("no --github-app-installation-id given")
+			}
+
+			if o.GithubApp.PrivateKeyFile == "" {
+				return errors.New("no --github-app-private-key given")
+			}
+
+		default:
+			o.GithubToken = os.Getenv("GCHL_GITHUB_TOKEN")
+			if o.GithubToken == "" {
+				return errors.New("no $GCHL_GITHUB_TOKEN environment variable defined")
+			}
+		}
+
+		if o.GithubURL == "" {
+			o.GithubURL = "https://github.com"
+		}
+
+	case "gitlab":
+		o.GitlabToken = os.Getenv("GCHL_GITLAB_TOKEN")
+		if o.GitlabToken == "" {
+			return errors.New("no $GCHL_GITLAB_TOKEN environment variable defined")
+		}
+
+		if o.GitlabURL == "" {
+			return errors.New("no --gitlab-url given")
+		}
+
+	case "gitea":
+		o.GiteaToken = os.Getenv("GCHL_GITEA_TOKEN")
+		if o.GiteaToken == "" {
+			return errors.New("no $GCHL_GITEA_TOKEN environment variable defined")
+		}
+
+		if o.GiteaURL == "" {
+			return errors.New("no --gitea-url given")
+		}
+
+	default:
+		return fmt.Errorf("invalid --forge %q, must be one of %v", o.Forge, forges)
+	}
+
+	if o.Forge != "github" {
+		githubFlags := []struct {
+			name string
+			set  bool
+		}{
+			{name: "--milestone", set: o.Milestone != ""},
+			{name: "--query", set: o.Query != ""},
+			{name: "--cache-dir", set: o.CacheDir != ""},
+			{name: "--record", set: o.RecordDir != ""},
+			{name: "--replay", set: o.ReplayDir != ""},
+			{name: "--github-app-id", set: o.GithubApp.Enabled()},
+		}
+
+		for _, flag := range githubFlags {
+			if flag.set {
+				return fmt.Errorf("%s can only be used with --forge=github", flag.name)
+			}
+		}
+	}
+
+	if o.Concurrency < 1 {
+		return errors.New("--concurrency must be at least 1")
 	}
 
 	if o.Organization == "" {
@@ -62,6 +225,14 @@ func (o *Options) Parse() error {
 		return errors.New("no --repository given")
 	}
 
+	if o.Start != "" && o.End != "" {
+		return errors.New("--start and --end cannot be used together")
+	}
+
+	if (o.Start != "" || o.End != "") && o.SearchQuery() != "" {
+		return errors.New("--start and --end cannot be combined with --milestone or --query")
+	}
+
 	if o.ForVersion == "" {
 		return errors.New("no --for-version given")
 	}
@@ -77,9 +248,73 @@ func (o *Options) Parse() error {
 		return fmt.Errorf("invalid --format %q, must be one of %v", o.OutputFormat, outputFormats)
 	}
 
+	if len(o.ComponentPaths) > 0 && o.Component == "" {
+		return errors.New("--component-path requires --component")
+	}
+
+	o.Component = strings.Trim(o.Component, "/")
+	if o.Component != "" && len(o.ComponentPaths) == 0 {
+		o.ComponentPaths = []string{o.Component}
+	}
+
+	if o.PreviousVersionStrategy == "" {
+		o.PreviousVersionStrategy = PreviousVersionFromBranches
+		if o.Component != "" {
+			o.PreviousVersionStrategy = PreviousVersionFromTags
+		}
+	}
+
+	if !slices.Contains(previousVersionStrategies, o.PreviousVersionStrategy) {
+		return fmt.Errorf("invalid --previous-version-strategy %q, must be one of %v", o.PreviousVersionStrategy, previousVersionStrategies)
+	}
+
+	if o.PreReleaseMode == "" {
+		o.PreReleaseMode = PreReleasesMerged
+	}
+
+	if !slices.Contains(preReleaseModes, o.PreReleaseMode) {
+		return fmt.Errorf("invalid --prereleases %q, must be one of %v", o.PreReleaseMode, preReleaseModes)
+	}
+
+	if _, err := o.NamingScheme(); err != nil {
+		return err
+	}
+
 	if o.OutputFormat == "" {
 		o.OutputFormat = "markdown"
 	}
 
 	return nil
 }
+
+// NamingScheme returns the naming scheme for release branches and tags. In
+// component mode, tags are prefixed with the component name.
+func (o *Options) NamingScheme() (*NamingScheme, error) {
+	tagTemplate := o.TagTemplate
+	if o.Component != "" {
+		if tagTemplate == "" {
+			tagTemplate = DefaultTagTemplate
+		}
+
+		tagTemplate = o.Component + "/" + tagTemplate
+	}
+
+	return NewNamingScheme(o.BranchTemplate, tagTemplate)
+}
+
+// SearchQuery returns the search query for the pull requests to include in
+// the changelog, or an empty string if the changelog should be based on the
+// commit history instead.
+func (o *Options) SearchQuery() string {
+	terms := []string{}
+
+	if o.Milestone != "" {
+		terms = append(terms, fmt.Sprintf("milestone:%q", o.Milestone))
+	}
+
+	if o.Query != "" {
+		terms = append(terms, o.Query)
+	}
+
+	return strings.Join(terms, " ")
+}
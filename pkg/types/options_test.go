@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestParseRejectsGitHubOnlyFlags(t *testing.T) {
+	testcases := []struct {
+		name    string
+		args    []string
+		invalid bool
+	}{
+		{
+			name: "milestone on GitHub",
+			args: []string{"--milestone", "v1.2"},
+		},
+		{
+			name:    "milestone on GitLab",
+			args:    []string{"--forge", "gitlab", "--milestone", "v1.2"},
+			invalid: true,
+		},
+		{
+			name:    "query on Gitea",
+			args:    []string{"--forge", "gitea", "--gitea-url", "https://git.example.com", "--query", "label:foo"},
+			invalid: true,
+		},
+		{
+			name:    "cache on GitLab",
+			args:    []string{"--forge", "gitlab", "--cache-dir", "/tmp/cache"},
+			invalid: true,
+		},
+		{
+			name:    "replay on Gitea",
+			args:    []string{"--forge", "gitea", "--gitea-url", "https://git.example.com", "--replay", "fixtures"},
+			invalid: true,
+		},
+		{
+			name: "concurrency on GitLab",
+			args: []string{"--forge", "gitlab", "--concurrency", "8"},
+		},
+		{
+			name:    "no concurrency",
+			args:    []string{"--forge", "gitlab", "--concurrency", "0"},
+			invalid: true,
+		},
+	}
+
+	t.Setenv("GCHL_GITHUB_TOKEN", "test")
+	t.Setenv("GCHL_GITLAB_TOKEN", "test")
+	t.Setenv("GCHL_GITEA_TOKEN", "test")
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			opts := Options{}
+
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			opts.AddFlags(fs)
+
+			args := append([]string{"--organization", "kubermatic", "--repository", "gchl", "--for-version", "v1.2.0"}, testcase.args...)
+			if err := fs.Parse(args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			err := opts.Parse()
+			if testcase.invalid && err == nil {
+				t.Fatal("Expected an error, but got none.")
+			}
+
+			if !testcase.invalid && err != nil {
+				t.Fatalf("Expected no error, got %v.", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mergeCommitRegex  = regexp.MustCompile(`^Merge pull request #([0-9]+) `)
+	squashCommitRegex = regexp.MustCompile(`\(#([0-9]+)\)$`)
+)
+
+// PullRequestNumber returns the pull request number from a commit title,
+// either from a merge commit ("Merge pull request #123 from ...") or from
+// a squashed commit ("Title (#123)"). 0 is returned if no number is found.
+func PullRequestNumber(title string) int {
+	title = strings.TrimSpace(title)
+
+	for _, regex := range []*regexp.Regexp{mergeCommitRegex, squashCommitRegex} {
+		if match := regex.FindStringSubmatch(title); match != nil {
+			number, err := strconv.Atoi(match[1])
+			if err == nil {
+				return number
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"testing"
+)
+
+func TestPullRequestNumber(t *testing.T) {
+	testcases := []struct {
+		title    string
+		expected int
+	}{
+		{
+			title:    "Merge pull request #123 from kubermatic/feature",
+			expected: 123,
+		},
+		{
+			title:    "Add support for things (#4567)",
+			expected: 4567,
+		},
+		{
+			title:    "Fix #12 for good",
+			expected: 0,
+		},
+		{
+			title:    "Initial commit",
+			expected: 0,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.title, func(t *testing.T) {
+			if result := PullRequestNumber(testcase.title); result != testcase.expected {
+				t.Fatalf("Expected %d, got %d.", testcase.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+
+	"k8c.io/gchl/pkg/ranges"
+	"k8c.io/gchl/pkg/source"
+	"k8c.io/gchl/pkg/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+// explainRange determines the commit range and walks its history like a
+// regular run would, but prints how the range has been resolved instead of
+// generating the changelog.
+func explainRange(ctx context.Context, log logrus.FieldLogger, opts *types.Options, client source.Source, out io.Writer) error {
+	if opts.SearchQuery() != "" {
+		return errors.New("the range cannot be explained for --milestone or --query")
+	}
+
+	log.Info("Resolving release commit range…")
+	rng, err := ranges.DetermineRange(ctx, client, log, opts)
+	if err != nil {
+		return fmt.Errorf("failed to determine commit range: %w", err)
+	}
+
+	// direct commits are always listed, to show why they are skipped
+	rng.IncludeDirectCommits = true
+
+	log.Info("Fetching commit history…")
+	commits, err := client.History(ctx, opts.Organization, opts.Repository, rng)
+	if err != nil {
+		return fmt.Errorf("failed to fetch repository history: %w", err)
+	}
+
+	// the filters replace the pull requests of cherry-picks, but the history
+	// should be explained with the pull requests of its commits
+	included, err := filterCommits(ctx, log, opts, client, slices.Clone(commits))
+	if err != nil {
+		return err
+	}
+
+	explanation := ranges.Explain(rng, commits, included)
+
+	if opts.OutputFormat == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(explanation)
+	}
+
+	return printExplanation(out, explanation)
+}
+
+// printExplanation prints the explanation as a human-readable table.
+func printExplanation(out io.Writer, explanation ranges.Explanation) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Head:\t%s\n", describeRef(explanation.HeadRef, explanation.Head))
+	fmt.Fprintf(w, "Branch:\t%s\n", explanation.Branch)
+
+	if explanation.PreviousRelease != "" {
+		fmt.Fprintf(w, "Previous release:\t%s\n", describeRef(explanation.PreviousRelease, explanation.PreviousReleaseHash))
+	}
+
+	if boundary := explanation.Boundary; boundary != nil {
+		fmt.Fprintf(w, "Boundary:\t%s (%s: %s)\n", boundary.Hash, boundary.Reason, boundary.Ref)
+	} else {
+		fmt.Fprintln(w, "Boundary:\tnone, reached the end of the history")
+	}
+
+	fmt.Fprintf(w, "Commits:\t%d\n", len(explanation.Commits))
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "COMMIT\tPR\tINCLUDED\tTITLE")
+	for _, commit := range explanation.Commits {
+		pr := "-"
+		if commit.PullRequest != 0 {
+			pr = fmt.Sprintf("#%d", commit.PullRequest)
+		}
+
+		included := "no"
+		if commit.Included {
+			included = "yes"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", types.ShortHash(commit.Hash), pr, included, commit.Title)
+	}
+
+	return w.Flush()
+}
+
+func describeRef(name string, hash string) string {
+	if name == "" {
+		return hash
+	}
+
+	return fmt.Sprintf("%s (%s)", name, hash)
+}